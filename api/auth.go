@@ -0,0 +1,28 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requireToken rejects requests without the matching bearer token
+func requireToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if !strings.HasPrefix(header, "Bearer ") {
+			jsonError(c, http.StatusUnauthorized, "authorization token is required")
+			return
+		}
+
+		given := strings.TrimPrefix(header, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			jsonError(c, http.StatusForbidden, "invalid authorization token")
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 
 	"github.com/figment-networks/avalanche-indexer/store"
@@ -21,3 +24,240 @@ func eventsSearchInput(c *gin.Context) *store.EventSearchInput {
 
 	return input
 }
+
+func evmLogsSearchInput(c *gin.Context) *store.EvmLogsSearch {
+	input := &store.EvmLogsSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	// Log addresses are stored in the checksum format
+	if input.Address != "" {
+		addrs := strings.Split(input.Address, ",")
+		for idx, addr := range addrs {
+			if !common.IsHexAddress(addr) {
+				badRequest(c, "invalid address value")
+				return nil
+			}
+			addrs[idx] = common.HexToAddress(addr).Hex()
+		}
+		input.Address = strings.Join(addrs, ",")
+	}
+
+	// Transaction hashes are stored in lower case
+	input.TxHash = strings.ToLower(input.TxHash)
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+func tokensSearchInput(c *gin.Context) *store.TokensSearch {
+	input := &store.TokensSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+// tokenTransfersSearchInput binds the transfers search, path params take precedence over the query
+func tokenTransfersSearchInput(c *gin.Context, token string, address string) *store.TokenTransfersSearch {
+	input := &store.TokenTransfersSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	if token != "" {
+		input.Token = token
+	}
+	if address != "" {
+		input.Address = address
+	}
+
+	if !checksumAddresses(c, &input.Token, &input.Address) {
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+// dexSearchInput binds the DEX events search, path params take precedence over the query
+func dexSearchInput(c *gin.Context, pair string, address string) *store.DexSearch {
+	input := &store.DexSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	if pair != "" {
+		input.Pair = pair
+	}
+	if address != "" {
+		input.Address = address
+	}
+
+	if !checksumAddresses(c, &input.Pair, &input.Address) {
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+// nftOwnersSearchInput binds the NFT owners search, path params take precedence over the query
+func nftOwnersSearchInput(c *gin.Context, token string, owner string) *store.NftOwnersSearch {
+	input := &store.NftOwnersSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	if token != "" {
+		input.Token = token
+	}
+	if owner != "" {
+		input.Owner = owner
+	}
+
+	if !checksumAddresses(c, &input.Token, &input.Owner) {
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+// tokenAllowancesSearchInput binds the allowances search of the owner address
+func tokenAllowancesSearchInput(c *gin.Context, owner string) *store.TokenAllowancesSearch {
+	input := &store.TokenAllowancesSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+	input.Owner = owner
+
+	if !checksumAddresses(c, &input.Token, &input.Owner, &input.Spender) {
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+// nftTransfersSearchInput binds the NFT transfers search of a single token
+func nftTransfersSearchInput(c *gin.Context, token string, tokenID string) *store.NftTransfersSearch {
+	input := &store.NftTransfersSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	input.Token = token
+	input.TokenID = tokenID
+
+	if !checksumAddresses(c, &input.Token, &input.Address) {
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+// evmInternalTxsSearchInput binds the internal transactions search, path params take precedence over the query
+func evmInternalTxsSearchInput(c *gin.Context, txHash string, address string) *store.EvmInternalTxsSearch {
+	input := &store.EvmInternalTxsSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	if txHash != "" {
+		input.TxHash = strings.ToLower(txHash)
+	}
+	if address != "" {
+		input.Address = address
+	}
+
+	if !checksumAddresses(c, &input.Address) {
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
+
+// checksumAddresses converts C-chain addresses into the checksum format used in storage
+func checksumAddresses(c *gin.Context, addrs ...*string) bool {
+	for _, addr := range addrs {
+		if *addr == "" {
+			continue
+		}
+		if !common.IsHexAddress(*addr) {
+			badRequest(c, "invalid address value")
+			return false
+		}
+		*addr = common.HexToAddress(*addr).Hex()
+	}
+	return true
+}
+
+func rewardsSearchInput(c *gin.Context) *store.RewardsSearch {
+	input := &store.RewardsSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return nil
+	}
+
+	return input
+}
@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
 	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/decoder"
 	"github.com/figment-networks/avalanche-indexer/indexer"
 	"github.com/figment-networks/avalanche-indexer/model"
 	"github.com/figment-networks/avalanche-indexer/store"
+	"github.com/figment-networks/avalanche-indexer/util"
 )
 
 type Server struct {
@@ -24,6 +28,8 @@ type Server struct {
 	logger      *logrus.Logger
 	db          *store.DB
 	rpc         *client.Client
+	decoder     *decoder.Registry
+	adminToken  string
 }
 
 type routeAnnotation struct {
@@ -31,13 +37,15 @@ type routeAnnotation struct {
 	Description string `json:"description"`
 }
 
-func NewServer(db *store.DB, rpc *client.Client, logger *logrus.Logger) *Server {
+func NewServer(db *store.DB, rpc *client.Client, logger *logrus.Logger, adminToken string) *Server {
 	srv := &Server{
 		engine:      gin.New(),
 		annotations: []routeAnnotation{},
 		db:          db,
 		logger:      logger,
 		rpc:         rpc,
+		decoder:     decoder.NewRegistry(db),
+		adminToken:  adminToken,
 	}
 
 	srv.setupMiddleware()
@@ -57,8 +65,17 @@ func (s *Server) setupRoutes() {
 	s.addRoute(http.MethodGet, "/network_stats", "Get network stats", s.handleNetworkStats)
 	s.addRoute(http.MethodGet, "/validators", "Get current validator set", s.handleValidators)
 	s.addRoute(http.MethodGet, "/validators/:id", "Get validator details", s.handleValidator)
+	s.addRoute(http.MethodGet, "/validators/:id/periods", "Get validator staking periods", s.handleValidatorPeriods)
 	s.addRoute(http.MethodGet, "/delegations", "Get active delegations", s.handleDelegations)
+	s.addRoute(http.MethodGet, "/rewards", "Get staking reward outputs", s.handleRewards)
 	s.addRoute(http.MethodGet, "/address/:id", "Get address details", s.handleAddress)
+	s.addRoute(http.MethodGet, "/address/:id/token_transfers", "Get address token transfers", s.handleAddressTokenTransfers)
+	s.addRoute(http.MethodGet, "/address/:id/nfts", "Get address NFT holdings", s.handleAddressNfts)
+	s.addRoute(http.MethodGet, "/address/:id/internal", "Get address internal transactions", s.handleAddressInternalTxs)
+	s.addRoute(http.MethodGet, "/address/:id/balance", "Get indexed address balance", s.handleAddressBalance)
+	s.addRoute(http.MethodGet, "/address/:id/balance/history", "Get indexed address balance history", s.handleAddressBalanceHistory)
+	s.addRoute(http.MethodGet, "/address/:id/trades", "Get address DEX swaps", s.handleAddressTrades)
+	s.addRoute(http.MethodGet, "/address/:id/approvals", "Get address token approvals", s.handleAddressApprovals)
 	s.addRoute(http.MethodGet, "/chains", "Get all blockchains", s.handleBlockchains)
 	s.addRoute(http.MethodGet, "/chain_sync_statuses", "Get indexer sync status", s.handleSyncStatus)
 	s.addRoute(http.MethodGet, "/assets", "Get all assets", s.handleAssets)
@@ -69,10 +86,32 @@ func (s *Server) setupRoutes() {
 	s.addRoute(http.MethodPost, "/transactions", "Transactions search", s.handleTransactions)
 	s.addRoute(http.MethodGet, "/transactions/:id", "Get transaction details", s.handleTransaction)
 	s.addRoute(http.MethodGet, "/transactions/:id/trace", "Get transaction trace", s.handleTransactionTrace)
+	s.addRoute(http.MethodGet, "/transactions/:id/internal", "Get transaction internal calls", s.handleTransactionInternalTxs)
 	s.addRoute(http.MethodGet, "/transaction_outputs/:id", "Get transaction output", s.handleTransactionOutput)
 	s.addRoute(http.MethodGet, "/transaction_types", "Get transaction types", s.handleTransactionTypeCounts)
+	s.addRoute(http.MethodGet, "/logs", "EVM logs search", s.handleEvmLogs)
+	s.addRoute(http.MethodGet, "/abis", "Get uploaded contract ABIs", s.handleContractAbis)
+	s.addRoute(http.MethodGet, "/abis/:address", "Get contract ABI", s.handleContractAbi)
+	s.addRoute(http.MethodGet, "/tokens", "Get tokens", s.handleTokens)
+	s.addRoute(http.MethodGet, "/tokens/:address", "Get token details", s.handleToken)
+	s.addRoute(http.MethodGet, "/tokens/:address/transfers", "Get token transfers", s.handleTokenTransfers)
+	s.addRoute(http.MethodGet, "/tokens/:address/inventory", "Get NFT collection inventory", s.handleTokenInventory)
+	s.addRoute(http.MethodGet, "/tokens/:address/nfts/:token_id/transfers", "Get NFT ownership history", s.handleNftTransfers)
+	s.addRoute(http.MethodGet, "/contracts/:address", "Get contract details", s.handleContract)
+	s.addRoute(http.MethodGet, "/dex/pairs/:address", "Get DEX pair details", s.handleDexPair)
+	s.addRoute(http.MethodGet, "/dex/pairs/:address/swaps", "Get DEX pair swaps", s.handleDexPairSwaps)
+	s.addRoute(http.MethodGet, "/dex/pairs/:address/liquidity", "Get DEX pair liquidity events", s.handleDexPairLiquidity)
 	s.addRoute(http.MethodGet, "/events", "Events search", s.handleEvents)
 	s.addRoute(http.MethodGet, "/events/:id", "Event details", s.handleEvent)
+	s.addRoute(http.MethodGet, "/failed_containers", "Failed containers search", s.handleFailedContainers)
+	s.addRoute(http.MethodGet, "/failed_containers/:id", "Failed container details", s.handleFailedContainer)
+
+	// Failed container management is only exposed when an admin token is configured
+	if s.adminToken != "" {
+		auth := requireToken(s.adminToken)
+		s.addRoute(http.MethodPost, "/failed_containers/:id/retry", "Schedule failed container retry", auth, s.handleFailedContainerStatus(model.FailedContainerStatusRetry))
+		s.addRoute(http.MethodPost, "/failed_containers/:id/resolve", "Resolve failed container", auth, s.handleFailedContainerStatus(model.FailedContainerStatusResolved))
+	}
 
 }
 
@@ -210,6 +249,15 @@ func (s *Server) handleValidators(c *gin.Context) {
 		return
 	}
 
+	if search.Status == model.ValidatorStatusPending {
+		validators, err := s.db.Validators.SearchPending(search)
+		if shouldReturn(c, err) {
+			return
+		}
+		jsonOk(c, validators)
+		return
+	}
+
 	validators, err := s.db.Validators.Search(search)
 	if shouldReturn(c, err) {
 		return
@@ -218,8 +266,35 @@ func (s *Server) handleValidators(c *gin.Context) {
 	jsonOk(c, validators)
 }
 
+// handleUpcomingValidators returns pending validators in the order of their start time
+func (s *Server) handleUpcomingValidators(c *gin.Context) {
+	input := &store.UpcomingValidatorsSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	validators, err := s.db.Validators.Upcoming(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, validators)
+}
+
 // handleValidator returns validator details
 func (s *Server) handleValidator(c *gin.Context) {
+	// The router can't register a static path next to the node ID parameter
+	if c.Param("id") == "upcoming" {
+		s.handleUpcomingValidators(c)
+		return
+	}
+
 	validator, err := s.db.Validators.FindByNodeID(c.Param("id"))
 	if shouldReturn(c, err) {
 		return
@@ -248,6 +323,28 @@ func (s *Server) handleValidator(c *gin.Context) {
 	})
 }
 
+// handleValidatorPeriods renders validation and delegation periods of the node
+func (s *Server) handleValidatorPeriods(c *gin.Context) {
+	input := &store.StakingPeriodsSearch{}
+
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+	input.NodeID = c.Param("id")
+
+	periods, err := s.db.Staking.SearchPeriods(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, periods)
+}
+
 // handleDelegations renders all available delegations
 func (s *Server) handleDelegations(c *gin.Context) {
 	search := store.DelegationsSearch{}
@@ -256,6 +353,10 @@ func (s *Server) handleDelegations(c *gin.Context) {
 		badRequest(c, err)
 		return
 	}
+	if err := search.Validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
 
 	delegations, err := s.db.Delegators.Search(search)
 	if shouldReturn(c, err) {
@@ -265,6 +366,21 @@ func (s *Server) handleDelegations(c *gin.Context) {
 	jsonOk(c, delegations)
 }
 
+// handleRewards renders reward outputs paid out to stakers
+func (s *Server) handleRewards(c *gin.Context) {
+	input := rewardsSearchInput(c)
+	if input == nil {
+		return
+	}
+
+	rewards, err := s.db.Rewards.Search(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, rewards)
+}
+
 // handleAddress returns account balance on a given chain
 func (s *Server) handleAddress(c *gin.Context) {
 	address := c.Param("id")
@@ -352,9 +468,49 @@ func (s *Server) handleTransaction(c *gin.Context) {
 	if shouldReturn(c, err) {
 		return
 	}
+
+	if tx.Type == model.TxTypeEvm {
+		evmTx, err := s.db.Evm.GetTransaction(tx.ID)
+		if err != nil && err != store.ErrNotFound {
+			serverError(c, err)
+			return
+		}
+		if err == nil {
+			tx.Evm = evmTx
+		}
+
+		input, err := s.evmTxInput(tx)
+		if shouldReturn(c, err) {
+			return
+		}
+		tx.DecodedInput = s.decoder.DecodeInput(tx.Metadata.GetString("receiver"), input)
+	}
+
 	jsonOk(c, tx)
 }
 
+// evmTxInput returns the EVM transaction input data, transactions indexed
+// without the payload record fall back to the stored trace
+func (s *Server) evmTxInput(tx *model.Transaction) ([]byte, error) {
+	if tx.Evm != nil {
+		return hexutil.Decode(tx.Evm.Input)
+	}
+
+	trace, err := s.db.Platform.GetEvmTrace(tx.ID)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	call := &client.Call{}
+	if err := json.Unmarshal([]byte(trace.Data), call); err != nil {
+		return nil, err
+	}
+	return call.Input, nil
+}
+
 // handleTransaction returns EVM trace details for a transaction
 func (s *Server) handleTransactionTrace(c *gin.Context) {
 	resp := TxTraceResponse{}
@@ -370,6 +526,7 @@ func (s *Server) handleTransactionTrace(c *gin.Context) {
 		if shouldReturn(c, err) {
 			return
 		}
+		s.decoder.DecodeTrace(traceCall)
 		resp.Trace = traceCall
 	}
 
@@ -384,6 +541,9 @@ func (s *Server) handleTransactionTrace(c *gin.Context) {
 		if shouldReturn(c, err) {
 			return
 		}
+		for idx, entry := range logs {
+			logs[idx].Decoded = s.decoder.DecodeLogHex(entry.Address, entry.Topics, entry.Data)
+		}
 
 		resp.Receipt = receipt
 		resp.Logs = logs
@@ -480,9 +640,359 @@ func (s Server) handleBlock(c *gin.Context) {
 		return
 	}
 
+	if block.Type == model.BlockTypeEvm {
+		evmBlock, err := s.db.Evm.GetBlock(block.ID)
+		if err != nil && err != store.ErrNotFound {
+			serverError(c, err)
+			return
+		}
+		if err == nil {
+			block.Evm = evmBlock
+		}
+	}
+
 	jsonOk(c, block)
 }
 
+// handleTransactionInternalTxs renders nested calls of a C-chain transaction
+func (s Server) handleTransactionInternalTxs(c *gin.Context) {
+	input := evmInternalTxsSearchInput(c, c.Param("id"), "")
+	if input == nil {
+		return
+	}
+	s.renderEvmInternalTxs(c, input)
+}
+
+// handleAddressInternalTxs renders internal calls made from or to the address
+func (s Server) handleAddressInternalTxs(c *gin.Context) {
+	input := evmInternalTxsSearchInput(c, "", c.Param("id"))
+	if input == nil {
+		return
+	}
+	s.renderEvmInternalTxs(c, input)
+}
+
+func (s Server) renderEvmInternalTxs(c *gin.Context, input *store.EvmInternalTxsSearch) {
+	txs, err := s.db.EvmInternalTxs.Search(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, txs)
+}
+
+// handleEvmLogs renders EVM logs matching the search parameters
+func (s Server) handleEvmLogs(c *gin.Context) {
+	input := evmLogsSearchInput(c)
+	if input == nil {
+		return
+	}
+
+	logs, err := s.db.EvmLogs.Search(input)
+	if shouldReturn(c, err) {
+		return
+	}
+	for idx := range logs {
+		logs[idx].Decoded = s.decoder.DecodeLogRecord(&logs[idx])
+	}
+
+	jsonOk(c, EvmLogsResponse{
+		Logs:       logs,
+		NextCursor: input.NextCursor(logs),
+	})
+}
+
+// handleTokens renders tokens matching the search parameters
+func (s Server) handleTokens(c *gin.Context) {
+	input := tokensSearchInput(c)
+	if input == nil {
+		return
+	}
+
+	tokens, err := s.db.Tokens.Search(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, tokens)
+}
+
+// handleToken renders a single token details
+func (s Server) handleToken(c *gin.Context) {
+	address := c.Param("address")
+	if !common.IsHexAddress(address) {
+		badRequest(c, "invalid address value")
+		return
+	}
+
+	token, err := s.db.Tokens.Get(common.HexToAddress(address).Hex())
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, token)
+}
+
+// handleContract renders the contract details with the proxy implementation history
+func (s Server) handleContract(c *gin.Context) {
+	address := c.Param("address")
+	if !common.IsHexAddress(address) {
+		badRequest(c, "invalid address value")
+		return
+	}
+	address = common.HexToAddress(address).Hex()
+
+	contract, err := s.db.Contracts.Get(address)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	implementations, err := s.db.Contracts.GetImplementations(address)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, ContractResponse{
+		Contract:        contract,
+		Implementations: implementations,
+	})
+}
+
+// handleDexPair renders the DEX pair details
+func (s Server) handleDexPair(c *gin.Context) {
+	address := c.Param("address")
+	if !common.IsHexAddress(address) {
+		badRequest(c, "invalid address value")
+		return
+	}
+
+	pair, err := s.db.Dex.GetPair(common.HexToAddress(address).Hex())
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, pair)
+}
+
+// handleDexPairSwaps renders swaps of a single pair
+func (s Server) handleDexPairSwaps(c *gin.Context) {
+	input := dexSearchInput(c, c.Param("address"), "")
+	if input == nil {
+		return
+	}
+	s.renderDexSwaps(c, input)
+}
+
+// handleAddressBalance renders the native C-chain balance from the indexed balance changes
+func (s Server) handleAddressBalance(c *gin.Context) {
+	address := c.Param("id")
+	if !common.IsHexAddress(address) {
+		badRequest(c, "invalid address value")
+		return
+	}
+
+	var height *big.Int
+	if heightVal := c.Query("height"); heightVal != "" {
+		height = big.NewInt(0)
+		if _, ok := height.SetString(heightVal, 10); !ok || !height.IsUint64() {
+			badRequest(c, "invalid height value")
+			return
+		}
+	}
+
+	// An explicit zero height returns the genesis balance
+	var atHeight *uint64
+	if height != nil {
+		atHeight = util.Uint64Prt(height.Uint64())
+	}
+
+	balance, err := s.db.Evm.GetBalance(common.HexToAddress(address).Hex(), atHeight)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, CBalanceResponse{
+		Balance: balance.String(),
+		Height:  height,
+	})
+}
+
+// handleAddressBalanceHistory renders native C-chain balance changes with the running balance
+func (s Server) handleAddressBalanceHistory(c *gin.Context) {
+	input := &store.EvmBalanceHistorySearch{}
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	input.Address = c.Param("id")
+	if !checksumAddresses(c, &input.Address) {
+		return
+	}
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	history, err := s.db.Evm.GetBalanceHistory(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, history)
+}
+
+// handleAddressTrades renders swaps initiated, sent or received by the address
+func (s Server) handleAddressTrades(c *gin.Context) {
+	input := dexSearchInput(c, "", c.Param("id"))
+	if input == nil {
+		return
+	}
+	s.renderDexSwaps(c, input)
+}
+
+func (s Server) renderDexSwaps(c *gin.Context, input *store.DexSearch) {
+	swaps, err := s.db.Dex.SearchSwaps(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, DexSwapsResponse{
+		Swaps:      swaps,
+		NextCursor: input.NextSwapsCursor(swaps),
+	})
+}
+
+// handleDexPairLiquidity renders liquidity deposits and withdrawals of a single pair
+func (s Server) handleDexPairLiquidity(c *gin.Context) {
+	input := dexSearchInput(c, c.Param("address"), "")
+	if input == nil {
+		return
+	}
+
+	events, err := s.db.Dex.SearchLiquidityEvents(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, DexLiquidityEventsResponse{
+		Events:     events,
+		NextCursor: input.NextLiquidityEventsCursor(events),
+	})
+}
+
+// handleTokenTransfers renders transfers of a single token
+func (s Server) handleTokenTransfers(c *gin.Context) {
+	input := tokenTransfersSearchInput(c, c.Param("address"), "")
+	if input == nil {
+		return
+	}
+	s.renderTokenTransfers(c, input)
+}
+
+// handleAddressTokenTransfers renders token transfers sent or received by the address
+func (s Server) handleAddressTokenTransfers(c *gin.Context) {
+	input := tokenTransfersSearchInput(c, "", c.Param("id"))
+	if input == nil {
+		return
+	}
+	s.renderTokenTransfers(c, input)
+}
+
+func (s Server) renderTokenTransfers(c *gin.Context, input *store.TokenTransfersSearch) {
+	transfers, err := s.db.Tokens.SearchTransfers(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, TokenTransfersResponse{
+		Transfers:  transfers,
+		NextCursor: input.NextCursor(transfers),
+	})
+}
+
+// handleTokenInventory renders current owners of the NFT collection tokens
+func (s Server) handleTokenInventory(c *gin.Context) {
+	input := nftOwnersSearchInput(c, c.Param("address"), "")
+	if input == nil {
+		return
+	}
+	s.renderNftOwners(c, input)
+}
+
+// handleAddressNfts renders NFTs currently held by the address
+func (s Server) handleAddressNfts(c *gin.Context) {
+	input := nftOwnersSearchInput(c, "", c.Param("id"))
+	if input == nil {
+		return
+	}
+	s.renderNftOwners(c, input)
+}
+
+func (s Server) renderNftOwners(c *gin.Context, input *store.NftOwnersSearch) {
+	owners, err := s.db.Nfts.SearchOwners(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, owners)
+}
+
+// handleAddressApprovals renders outstanding token allowances granted by the address
+func (s Server) handleAddressApprovals(c *gin.Context) {
+	input := tokenAllowancesSearchInput(c, c.Param("id"))
+	if input == nil {
+		return
+	}
+
+	allowances, err := s.db.Tokens.SearchAllowances(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, allowances)
+}
+
+// handleNftTransfers renders transfers of a single NFT
+func (s Server) handleNftTransfers(c *gin.Context) {
+	input := nftTransfersSearchInput(c, c.Param("address"), c.Param("token_id"))
+	if input == nil {
+		return
+	}
+
+	transfers, err := s.db.Nfts.SearchTransfers(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, transfers)
+}
+
+// handleContractAbis renders all uploaded contract ABIs
+func (s Server) handleContractAbis(c *gin.Context) {
+	abis, err := s.db.ContractAbis.GetAll()
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, abis)
+}
+
+// handleContractAbi renders the uploaded ABI of a single contract
+func (s Server) handleContractAbi(c *gin.Context) {
+	address := c.Param("address")
+	if !checksumAddresses(c, &address) {
+		return
+	}
+
+	record, err := s.db.ContractAbis.Get(address)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, record)
+}
+
 // handleEvents renders events matching the search parameters
 func (s Server) handleEvents(c *gin.Context) {
 	input := eventsSearchInput(c)
@@ -507,3 +1017,61 @@ func (s Server) handleEvent(c *gin.Context) {
 
 	jsonOk(c, event)
 }
+
+// handleFailedContainers renders failed containers matching the search parameters
+func (s Server) handleFailedContainers(c *gin.Context) {
+	input := &store.FailedContainersSearch{}
+	if err := c.Bind(input); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := input.Validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	containers, err := s.db.FailedContainers.Search(input)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, containers)
+}
+
+// handleFailedContainer renders a single failed container details
+func (s Server) handleFailedContainer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, "invalid id value")
+		return
+	}
+
+	container, err := s.db.FailedContainers.FindByID(id)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, container)
+}
+
+// handleFailedContainerStatus updates the failed container status
+func (s Server) handleFailedContainerStatus(status string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			badRequest(c, "invalid id value")
+			return
+		}
+
+		if err := s.db.FailedContainers.UpdateStatus(id, status); shouldReturn(c, err) {
+			return
+		}
+
+		container, err := s.db.FailedContainers.FindByID(id)
+		if shouldReturn(c, err) {
+			return
+		}
+
+		jsonOk(c, container)
+	}
+}
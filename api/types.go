@@ -41,3 +41,28 @@ type TxTraceResponse struct {
 	Logs    []model.EvmLog    `json:"logs"`
 	Trace   *client.Call      `json:"trace"`
 }
+
+type EvmLogsResponse struct {
+	Logs       []model.EvmLogRecord `json:"logs"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+}
+
+type ContractResponse struct {
+	*model.Contract
+	Implementations []model.ContractImplementation `json:"implementations"`
+}
+
+type DexSwapsResponse struct {
+	Swaps      []model.DexSwap `json:"swaps"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+type DexLiquidityEventsResponse struct {
+	Events     []model.DexLiquidityEvent `json:"events"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+}
+
+type TokenTransfersResponse struct {
+	Transfers  []model.TokenTransfer `json:"transfers"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
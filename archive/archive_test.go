@@ -0,0 +1,52 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	data, err := formatting.EncodeWithChecksum(formatting.Hex, []byte{1, 2, 3})
+	require.NoError(t, err)
+
+	for _, format := range []string{FormatJSONL, FormatBinary} {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+
+			writer, err := NewWriter(dir, "chain", format, 2)
+			require.NoError(t, err)
+
+			ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+			for idx := int64(1); idx <= 5; idx++ {
+				require.NoError(t, writer.Write(Container{
+					Index:     idx,
+					Timestamp: ts.Add(time.Duration(idx) * time.Second),
+					Data:      data,
+				}))
+			}
+			require.NoError(t, writer.Close())
+			assert.Error(t, writer.Write(Container{Index: 5}))
+
+			reader, err := NewReader(dir)
+			require.NoError(t, err)
+			require.NoError(t, reader.Verify())
+
+			manifest := reader.Manifest()
+			assert.Equal(t, int64(1), manifest.StartIndex)
+			assert.Equal(t, int64(5), manifest.EndIndex)
+			assert.Len(t, manifest.Chunks, 3)
+
+			containers, err := reader.Containers(2, 3)
+			require.NoError(t, err)
+			require.Len(t, containers, 3)
+			assert.Equal(t, int64(2), containers[0].Index)
+			assert.Equal(t, int64(4), containers[2].Index)
+			assert.Equal(t, data, containers[2].Data)
+			assert.True(t, ts.Add(4*time.Second).Equal(containers[2].Timestamp))
+		})
+	}
+}
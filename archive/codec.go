@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+)
+
+// encoder writes containers into a chunk file
+type encoder interface {
+	Encode(Container) error
+}
+
+// decoder reads containers from a chunk file, returns io.EOF when done
+type decoder interface {
+	Decode() (*Container, error)
+}
+
+func newEncoder(format string, w io.Writer) encoder {
+	if format == FormatBinary {
+		return binaryEncoder{w}
+	}
+	return jsonEncoder{json.NewEncoder(w)}
+}
+
+func newDecoder(format string, r io.Reader) decoder {
+	if format == FormatBinary {
+		return binaryDecoder{bufio.NewReader(r)}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), maxRecordSize)
+
+	return jsonDecoder{scanner}
+}
+
+type jsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (e jsonEncoder) Encode(c Container) error {
+	return e.enc.Encode(c)
+}
+
+type jsonDecoder struct {
+	scanner *bufio.Scanner
+}
+
+func (d jsonDecoder) Decode() (*Container, error) {
+	if !d.scanner.Scan() {
+		if err := d.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	c := &Container{}
+	return c, json.Unmarshal(d.scanner.Bytes(), c)
+}
+
+// binaryEncoder writes containers as: index (uint64), timestamp (unix nanos, int64),
+// data length (uint32) and raw container bytes without the checksum.
+type binaryEncoder struct {
+	w io.Writer
+}
+
+func (e binaryEncoder) Encode(c Container) error {
+	data, err := formatting.Decode(formatting.Hex, c.Data)
+	if err != nil {
+		return err
+	}
+
+	header := make([]byte, 20)
+	binary.BigEndian.PutUint64(header[0:8], uint64(c.Index))
+	binary.BigEndian.PutUint64(header[8:16], uint64(c.Timestamp.UnixNano()))
+	binary.BigEndian.PutUint32(header[16:20], uint32(len(data)))
+
+	if _, err := e.w.Write(header); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+type binaryDecoder struct {
+	r *bufio.Reader
+}
+
+func (d binaryDecoder) Decode() (*Container, error) {
+	header := make([]byte, 20)
+	if _, err := io.ReadFull(d.r, header); err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[16:20])
+	if size > maxRecordSize {
+		return nil, errors.New("container record is too large")
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(d.r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	encoded, err := formatting.EncodeWithChecksum(formatting.Hex, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Container{
+		Index:     int64(binary.BigEndian.Uint64(header[0:8])),
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(header[8:16]))).UTC(),
+		Data:      encoded,
+	}, nil
+}
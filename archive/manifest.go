@@ -0,0 +1,104 @@
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// ManifestFile is the name of the archive manifest file
+	ManifestFile = "manifest.json"
+
+	// FormatJSONL stores containers as gzipped JSON lines
+	FormatJSONL = "jsonl"
+
+	// FormatBinary stores containers as gzipped length-prefixed binary records
+	FormatBinary = "binary"
+
+	// maxRecordSize is the max size of a single encoded container record
+	maxRecordSize = 64 * 1024 * 1024
+)
+
+// Manifest describes the archived containers of a single chain
+type Manifest struct {
+	Chain      string    `json:"chain"`
+	Format     string    `json:"format"`
+	StartIndex int64     `json:"start_index"`
+	EndIndex   int64     `json:"end_index"`
+	EndTime    time.Time `json:"end_time"`
+	Chunks     []Chunk   `json:"chunks"`
+}
+
+// Chunk describes a single archive file with a continuous range of containers
+type Chunk struct {
+	File       string `json:"file"`
+	StartIndex int64  `json:"start_index"`
+	EndIndex   int64  `json:"end_index"`
+	Count      int    `json:"count"`
+	SHA256     string `json:"sha256"`
+}
+
+// Container is an archived chain container
+type Container struct {
+	Index     int64     `json:"index"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      string    `json:"data"`
+}
+
+// ReadManifest reads the manifest from the archive directory
+func ReadManifest(dir string) (*Manifest, error) {
+	f, err := os.Open(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	manifest := &Manifest{}
+	if err := json.NewDecoder(f).Decode(manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, manifest.Validate()
+}
+
+// WriteManifest writes the manifest into the archive directory
+func WriteManifest(dir string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, ManifestFile), data, 0644)
+}
+
+// ValidateFormat checks if the archive format is supported
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatJSONL, FormatBinary:
+		return nil
+	default:
+		return errors.New("unsupported archive format: " + format)
+	}
+}
+
+func (m Manifest) Validate() error {
+	if m.Chain == "" {
+		return errors.New("manifest chain is required")
+	}
+	if err := ValidateFormat(m.Format); err != nil {
+		return err
+	}
+
+	for idx, chunk := range m.Chunks {
+		if chunk.StartIndex > chunk.EndIndex {
+			return errors.New("invalid chunk range: " + chunk.File)
+		}
+		if idx > 0 && chunk.StartIndex <= m.Chunks[idx-1].EndIndex {
+			return errors.New("chunks are not ordered: " + chunk.File)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package archive
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Reader reads containers from a chain archive directory
+type Reader struct {
+	dir      string
+	manifest *Manifest
+
+	// containers of the most recently read chunk
+	chunk      *Chunk
+	containers []Container
+}
+
+// NewReader returns a new reader for the archive directory
+func NewReader(dir string) (*Reader, error) {
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		dir:      dir,
+		manifest: manifest,
+	}, nil
+}
+
+// Manifest returns the archive manifest
+func (r *Reader) Manifest() *Manifest {
+	return r.manifest
+}
+
+// Containers returns up to limit containers starting at the given index
+func (r *Reader) Containers(start int64, limit int) ([]Container, error) {
+	result := []Container{}
+
+	for idx := range r.manifest.Chunks {
+		chunk := &r.manifest.Chunks[idx]
+		if chunk.EndIndex < start {
+			continue
+		}
+
+		containers, err := r.readChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range containers {
+			if c.Index < start {
+				continue
+			}
+			result = append(result, c)
+
+			if len(result) == limit {
+				return result, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Verify checks the checksums of all archive chunks
+func (r *Reader) Verify() error {
+	for _, chunk := range r.manifest.Chunks {
+		f, err := os.Open(filepath.Join(r.dir, chunk.File))
+		if err != nil {
+			return err
+		}
+
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		if sum := hex.EncodeToString(h.Sum(nil)); sum != chunk.SHA256 {
+			return fmt.Errorf("checksum mismatch for chunk %s", chunk.File)
+		}
+	}
+	return nil
+}
+
+func (r *Reader) readChunk(chunk *Chunk) ([]Container, error) {
+	if r.chunk == chunk {
+		return r.containers, nil
+	}
+
+	f, err := os.Open(filepath.Join(r.dir, chunk.File))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	containers := make([]Container, 0, chunk.Count)
+	dec := newDecoder(r.manifest.Format, gz)
+
+	for {
+		c, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, *c)
+	}
+
+	r.chunk = chunk
+	r.containers = containers
+
+	return containers, nil
+}
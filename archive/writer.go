@@ -0,0 +1,157 @@
+package archive
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Writer writes chain containers into chunk files of the archive directory.
+// The manifest is updated after every completed chunk, so an interrupted export
+// can be resumed from the manifest end index.
+type Writer struct {
+	dir       string
+	manifest  *Manifest
+	chunkSize int
+
+	chunk *Chunk
+	file  *os.File
+	gz    *gzip.Writer
+	hash  hash.Hash
+	enc   encoder
+}
+
+// NewWriter returns a new writer for the archive directory, existing archive is appended to
+func NewWriter(dir string, chain string, format string, chunkSize int) (*Writer, error) {
+	if err := ValidateFormat(format); err != nil {
+		return nil, err
+	}
+	if chunkSize <= 0 {
+		return nil, errors.New("chunk size must be positive")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		manifest = &Manifest{
+			Chain:  chain,
+			Format: format,
+			Chunks: []Chunk{},
+		}
+	}
+
+	if manifest.Chain != chain {
+		return nil, fmt.Errorf("archive chain mismatch: expected %s, got %s", chain, manifest.Chain)
+	}
+	if manifest.Format != format {
+		return nil, fmt.Errorf("archive format mismatch: expected %s, got %s", format, manifest.Format)
+	}
+
+	return &Writer{
+		dir:       dir,
+		manifest:  manifest,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// Manifest returns the archive manifest
+func (w *Writer) Manifest() *Manifest {
+	return w.manifest
+}
+
+// Write appends the container to the archive
+func (w *Writer) Write(c Container) error {
+	if w.lastIndex() >= c.Index {
+		return fmt.Errorf("container %d is already archived", c.Index)
+	}
+
+	if w.chunk == nil {
+		if err := w.openChunk(c.Index); err != nil {
+			return err
+		}
+	}
+
+	if err := w.enc.Encode(c); err != nil {
+		return err
+	}
+
+	if w.chunk.Count == 0 {
+		w.chunk.StartIndex = c.Index
+	}
+	w.chunk.EndIndex = c.Index
+	w.chunk.Count++
+
+	w.manifest.EndIndex = c.Index
+	w.manifest.EndTime = c.Timestamp
+
+	if w.chunk.Count >= w.chunkSize {
+		return w.closeChunk()
+	}
+	return nil
+}
+
+// Close completes the current chunk and writes the manifest
+func (w *Writer) Close() error {
+	if w.chunk == nil {
+		return nil
+	}
+	return w.closeChunk()
+}
+
+func (w *Writer) lastIndex() int64 {
+	if w.chunk != nil && w.chunk.Count > 0 {
+		return w.chunk.EndIndex
+	}
+	if len(w.manifest.Chunks) > 0 {
+		return w.manifest.Chunks[len(w.manifest.Chunks)-1].EndIndex
+	}
+	return -1
+}
+
+func (w *Writer) openChunk(startIndex int64) error {
+	name := fmt.Sprintf("%s-%012d.%s.gz", w.manifest.Chain, startIndex, w.manifest.Format)
+
+	file, err := os.Create(filepath.Join(w.dir, name))
+	if err != nil {
+		return err
+	}
+
+	w.file = file
+	w.hash = sha256.New()
+	w.gz = gzip.NewWriter(io.MultiWriter(file, w.hash))
+	w.enc = newEncoder(w.manifest.Format, w.gz)
+	w.chunk = &Chunk{File: name}
+
+	return nil
+}
+
+func (w *Writer) closeChunk() error {
+	if err := w.gz.Close(); err != nil {
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	w.chunk.SHA256 = hex.EncodeToString(w.hash.Sum(nil))
+
+	if len(w.manifest.Chunks) == 0 {
+		w.manifest.StartIndex = w.chunk.StartIndex
+	}
+	w.manifest.Chunks = append(w.manifest.Chunks, *w.chunk)
+	w.chunk = nil
+
+	return WriteManifest(w.dir, w.manifest)
+}
@@ -18,6 +18,7 @@ var cliOpts struct {
 	command    string
 	configPath string
 	version    bool
+	args       []string
 }
 
 func init() {
@@ -25,6 +26,8 @@ func init() {
 	flag.StringVar(&cliOpts.configPath, "config", "", "Path to configuration file")
 	flag.BoolVar(&cliOpts.version, "v", false, "Show version")
 	flag.Parse()
+
+	cliOpts.args = flag.Args()
 }
 
 func Run() {
@@ -62,6 +65,10 @@ func Run() {
 	}
 
 	rpc := client.New(config.RPCEndpoint)
+	if err := rpc.Evm.SetTracer(config.EvmTracer, config.EvmTraceTimeout); err != nil {
+		log.Fatal("rpc init error:", err)
+	}
+
 	var command cliCommand
 
 	shared.SetBech32HRP(config.NetworkID)
@@ -71,15 +78,37 @@ func Run() {
 	case "status":
 		command = cmd.NewStatusCommand(rpc, log)
 	case "sync":
-		command = cmd.NewSyncCommand(log, db, rpc, config.NetworkID, config.EvmChainID)
+		command = cmd.NewSyncCommand(log, db, rpc, config.NetworkID, config.EvmChainID, config.GetFailurePolicy(), config.GetEvmTraceConfig())
 	case "worker":
-		command = cmd.NewWorkerCommand(db, rpc, log, config.GetSyncInterval(), config.GetPurgeInterval(), config.NetworkID, config.EvmChainID)
+		command = cmd.NewWorkerCommand(db, rpc, log, config.GetSyncInterval(), config.GetPurgeInterval(), config.NetworkID, config.EvmChainID, config.WorkerSource, config.ArchiveDir, config.GetFailurePolicy(), config.GetEvmTraceConfig())
+	case "ingest":
+		command = cmd.NewIngestCommand(db, log, config.IPCRoot, config.IPCChains)
 	case "server":
-		command = cmd.NewServerCommand(db, config.ServerAddr, log, rpc)
+		command = cmd.NewServerCommand(db, config.ServerAddr, config.AdminToken, log, rpc)
 	case "migrate", "migrate:up", "migrate:down", "migrate:redo":
 		command = cmd.NewMigrateCommand(cliOpts.command, config.DatabaseURL, log)
 	case "purge":
 		command = cmd.NewPurgeCommand(db, log)
+	case "archive:export", "archive:import":
+		command = cmd.NewArchiveCommand(
+			cliOpts.command,
+			cliOpts.args,
+			db,
+			rpc,
+			log,
+			config.ArchiveDir,
+			config.ArchiveFormat,
+			config.ArchiveChunkSize,
+			config.NetworkID,
+			config.EvmChainID,
+			config.GetFailurePolicy(),
+		)
+	case "reindex":
+		command = cmd.NewReindexCommand(cliOpts.args, db, rpc, log)
+	case "failed", "failed:retry", "failed:resolve":
+		command = cmd.NewFailedCommand(cliOpts.command, cliOpts.args, db, log)
+	case "abi:import":
+		command = cmd.NewAbiCommand(cliOpts.args, db, log)
 	default:
 		log.Fatal("invalid command")
 	}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"errors"
+	"io/ioutil"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/avalanche-indexer/decoder"
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+type AbiCommand struct {
+	args   []string
+	db     *store.DB
+	logger *logrus.Logger
+}
+
+func NewAbiCommand(args []string, db *store.DB, logger *logrus.Logger) AbiCommand {
+	return AbiCommand{
+		args:   args,
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Run imports the contract ABI from a JSON file, with an optional contract name
+func (cmd AbiCommand) Run() error {
+	if len(cmd.args) < 2 {
+		return errors.New("contract address and abi file path are required")
+	}
+
+	address := cmd.args[0]
+	if !common.IsHexAddress(address) {
+		return errors.New("invalid contract address")
+	}
+	address = common.HexToAddress(address).Hex()
+
+	data, err := ioutil.ReadFile(cmd.args[1])
+	if err != nil {
+		return err
+	}
+	if _, err := decoder.ParseABI(string(data)); err != nil {
+		return err
+	}
+
+	record := &model.ContractAbi{
+		Address:   address,
+		Abi:       string(data),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if len(cmd.args) > 2 {
+		record.Name = &cmd.args[2]
+	}
+
+	if err := cmd.db.ContractAbis.Save(record); err != nil {
+		return err
+	}
+
+	cmd.logger.WithField("address", address).Info("contract abi imported")
+	return nil
+}
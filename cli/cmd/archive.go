@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/avalanche-indexer/archive"
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/indexer/avm"
+	"github.com/figment-networks/avalanche-indexer/indexer/codec"
+	"github.com/figment-networks/avalanche-indexer/indexer/cvm"
+	"github.com/figment-networks/avalanche-indexer/indexer/pvm"
+	"github.com/figment-networks/avalanche-indexer/indexer/shared"
+	"github.com/figment-networks/avalanche-indexer/store"
+	"github.com/figment-networks/avalanche-indexer/util"
+)
+
+const (
+	// max number of containers allowed by the index API in a single request
+	archiveFetchBatchSize = 1024
+)
+
+type ArchiveCommand struct {
+	command string
+	args    []string
+	db      *store.DB
+	rpc     *client.Client
+	logger  *logrus.Logger
+
+	archiveDir    string
+	format        string
+	chunkSize     int
+	networkID     uint32
+	evmChainID    uint32
+	failurePolicy shared.FailurePolicy
+}
+
+func NewArchiveCommand(
+	command string,
+	args []string,
+	db *store.DB,
+	rpc *client.Client,
+	logger *logrus.Logger,
+	archiveDir string,
+	format string,
+	chunkSize int,
+	networkID uint32,
+	evmChainID uint32,
+	failurePolicy shared.FailurePolicy,
+) ArchiveCommand {
+	return ArchiveCommand{
+		command: command,
+		args:    args,
+		db:      db,
+		rpc:     rpc,
+		logger:  logger,
+
+		archiveDir:    archiveDir,
+		format:        format,
+		chunkSize:     chunkSize,
+		networkID:     networkID,
+		evmChainID:    evmChainID,
+		failurePolicy: failurePolicy,
+	}
+}
+
+func (cmd ArchiveCommand) Run() error {
+	if cmd.archiveDir == "" {
+		return errors.New("archive dir is required")
+	}
+	if len(cmd.args) == 0 {
+		return errors.New("chain alias is required")
+	}
+
+	alias := cmd.args[0]
+	switch alias {
+	case "X", "P", "C":
+	default:
+		return fmt.Errorf("invalid chain alias: %q", alias)
+	}
+
+	chainID, err := cmd.rpc.Info.BlockchainID(alias)
+	if err != nil {
+		return err
+	}
+
+	switch cmd.command {
+	case "archive:export":
+		return cmd.export(alias, chainID)
+	case "archive:import":
+		return cmd.replay(alias, chainID)
+	default:
+		return fmt.Errorf("invalid archive command: %q", cmd.command)
+	}
+}
+
+// export writes containers from the index API into the chain archive.
+// Optional arguments set the start and end container index.
+func (cmd ArchiveCommand) export(alias string, chainID string) (err error) {
+	writer, err := archive.NewWriter(filepath.Join(cmd.archiveDir, chainID), chainID, cmd.format, cmd.chunkSize)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := writer.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	start, end, err := cmd.exportRange(alias, writer.Manifest())
+	if err != nil {
+		return err
+	}
+
+	cmd.logger.
+		WithFields(logrus.Fields{"chain": alias, "start": start, "end": end}).
+		Info("starting archive export")
+
+	signals := initSignals()
+
+	for idx := start; idx <= end; {
+		select {
+		case s := <-signals:
+			cmd.logger.Info("received signal: ", s)
+			return nil
+		default:
+		}
+
+		limit := archiveFetchBatchSize
+		if remaining := end - idx + 1; remaining < int64(limit) {
+			limit = int(remaining)
+		}
+
+		resp, err := cmd.rpc.Index.GetContainerRange(alias, int(idx), limit)
+		if err != nil {
+			return err
+		}
+		if len(resp.Containers) == 0 {
+			break
+		}
+
+		for _, c := range resp.Containers {
+			index, err := util.ParseInt64(c.Index)
+			if err != nil {
+				return err
+			}
+
+			if err := writer.Write(archive.Container{Index: index, Timestamp: c.Timestamp, Data: c.Bytes}); err != nil {
+				return err
+			}
+			idx = index + 1
+		}
+
+		cmd.logger.WithField("chain", alias).WithField("index", idx-1).Info("exported containers")
+	}
+
+	return nil
+}
+
+func (cmd ArchiveCommand) exportRange(alias string, manifest *archive.Manifest) (int64, int64, error) {
+	var start, end int64
+
+	// Existing archives are continued after the last archived container
+	if len(manifest.Chunks) > 0 {
+		start = manifest.EndIndex + 1
+	}
+
+	if len(cmd.args) > 1 {
+		val, err := strconv.ParseInt(cmd.args[1], 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if len(manifest.Chunks) > 0 {
+			switch {
+			case val <= manifest.EndIndex:
+				return 0, 0, fmt.Errorf("start index %d overlaps the archived range %d-%d", val, manifest.StartIndex, manifest.EndIndex)
+			case val > manifest.EndIndex+1:
+				return 0, 0, fmt.Errorf("start index %d leaves a gap after the archived index %d", val, manifest.EndIndex)
+			}
+		}
+		start = val
+	}
+
+	if len(cmd.args) > 2 {
+		val, err := strconv.ParseInt(cmd.args[2], 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		end = val
+	} else {
+		lastAccepted, err := cmd.rpc.Index.GetLastAccepted(alias)
+		if err != nil {
+			return 0, 0, err
+		}
+		if end, err = util.ParseInt64(lastAccepted.Index); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return start, end, nil
+}
+
+// replay runs the archived containers through the chain worker
+func (cmd ArchiveCommand) replay(alias string, chainID string) error {
+	dir := filepath.Join(cmd.archiveDir, chainID)
+
+	reader, err := archive.NewReader(dir)
+	if err != nil {
+		return err
+	}
+	if err := reader.Verify(); err != nil {
+		return err
+	}
+
+	source, err := shared.NewArchiveSource(dir, chainID)
+	if err != nil {
+		return err
+	}
+
+	_, assetID, err := genesis.Genesis(cmd.networkID, "")
+	if err != nil {
+		return err
+	}
+
+	var runFn func() error
+
+	switch alias {
+	case "X":
+		worker := avm.NewWorker(source, cmd.db, codec.AVM, chainID, assetID.String(), cmd.failurePolicy)
+		runFn = worker.Run
+	case "P":
+		worker := pvm.NewWorker(source, cmd.db, codec.PVM, chainID, assetID.String(), cmd.failurePolicy)
+		runFn = worker.Run
+	case "C":
+		worker := cvm.NewWorker(cmd.db, codec.EVM, source, &cmd.rpc.Evm, chainID, assetID.String(), big.NewInt(int64(cmd.evmChainID)), cmd.failurePolicy)
+		runFn = worker.Run
+	}
+
+	cmd.logger.
+		WithFields(logrus.Fields{"chain": alias, "end": reader.Manifest().EndIndex}).
+		Info("starting archive import")
+
+	for {
+		if err := runFn(); err != nil {
+			return err
+		}
+
+		status, err := cmd.db.Platform.GetSyncStatus(source.StatusKey())
+		if err != nil {
+			return err
+		}
+
+		cmd.logger.WithField("chain", alias).WithField("index", status.IndexID).Info("imported containers")
+
+		if status.AtTip() {
+			return nil
+		}
+	}
+}
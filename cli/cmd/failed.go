@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+type FailedCommand struct {
+	command string
+	args    []string
+	db      *store.DB
+	logger  *logrus.Logger
+}
+
+func NewFailedCommand(command string, args []string, db *store.DB, logger *logrus.Logger) FailedCommand {
+	return FailedCommand{
+		command: command,
+		args:    args,
+		db:      db,
+		logger:  logger,
+	}
+}
+
+func (cmd FailedCommand) Run() error {
+	switch cmd.command {
+	case "failed:retry":
+		return cmd.updateStatus(model.FailedContainerStatusRetry)
+	case "failed:resolve":
+		return cmd.updateStatus(model.FailedContainerStatusResolved)
+	default:
+		return cmd.list()
+	}
+}
+
+func (cmd FailedCommand) list() error {
+	search := &store.FailedContainersSearch{}
+	if len(cmd.args) > 0 {
+		search.Chain = cmd.args[0]
+	}
+
+	containers, err := cmd.db.FailedContainers.Search(search)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		cmd.logger.
+			WithField("id", c.ID).
+			WithField("chain", c.Chain).
+			WithField("index", c.IndexID).
+			WithField("status", c.Status).
+			WithField("attempts", c.Attempts).
+			Info(c.Error)
+	}
+
+	return nil
+}
+
+func (cmd FailedCommand) updateStatus(status string) error {
+	if len(cmd.args) == 0 {
+		return errors.New("failed container ID is required")
+	}
+
+	for _, arg := range cmd.args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return err
+		}
+
+		if err := cmd.db.FailedContainers.UpdateStatus(id, status); err != nil {
+			return err
+		}
+
+		cmd.logger.WithField("id", id).WithField("status", status).Info("failed container updated")
+	}
+
+	return nil
+}
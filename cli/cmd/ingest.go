@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"sync"
@@ -38,12 +40,22 @@ func NewIngestCommand(db *store.DB, logger *logrus.Logger, ipcRoot string, ipcCh
 }
 
 func (cmd IngestCommand) Run() error {
+	if cmd.ipcRoot == "" {
+		return errors.New("ipc root is required")
+	}
+	if len(cmd.ipcChains) == 0 {
+		return errors.New("ipc chains are required")
+	}
+
 	topics := make([]*model.RawMessageTopic, len(cmd.ipcChains))
 
 	for idx, chain := range cmd.ipcChains {
 		cmd.logger.Info("creating raw message topic:", chain)
 
 		chunks := strings.SplitN(chain, "-", 3)
+		if len(chunks) != 3 {
+			return fmt.Errorf("invalid ipc chain format: %q", chain)
+		}
 
 		topic, err := cmd.db.RawMessages.CreateOrFindTopic(chunks[2], chunks[0], chunks[1])
 		if err != nil {
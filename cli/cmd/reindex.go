@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/indexer/cvm"
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+type ReindexCommand struct {
+	args   []string
+	db     *store.DB
+	rpc    *client.Client
+	logger *logrus.Logger
+}
+
+func NewReindexCommand(args []string, db *store.DB, rpc *client.Client, logger *logrus.Logger) ReindexCommand {
+	return ReindexCommand{
+		args:   args,
+		db:     db,
+		rpc:    rpc,
+		logger: logger,
+	}
+}
+
+func (cmd ReindexCommand) Run() error {
+	if len(cmd.args) != 2 {
+		return errors.New("chain alias and start index are required")
+	}
+
+	alias := cmd.args[0]
+
+	start, err := strconv.ParseInt(cmd.args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	if start < 0 {
+		return errors.New("invalid start index")
+	}
+
+	cmd.logger.WithField("chain", alias).WithField("start", start).Info("starting reindex")
+
+	switch alias {
+	case "X", "P", "C":
+		err = cmd.reindexContainers(alias, start)
+	case "C_evm":
+		err = cmd.reindexEvm(start)
+	case "P_events":
+		err = cmd.reindexEvents(start)
+	default:
+		return fmt.Errorf("invalid chain alias: %q", alias)
+	}
+
+	if err == nil {
+		cmd.logger.WithField("chain", alias).Info("reindex finished")
+	}
+	return err
+}
+
+// reindexContainers rewinds the chain worker to the given index container
+func (cmd ReindexCommand) reindexContainers(alias string, start int64) error {
+	chainID, err := cmd.rpc.Info.BlockchainID(alias)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cmd.rpc.Index.GetContainerRange(alias, int(start), 1)
+	if err != nil {
+		return err
+	}
+	if len(resp.Containers) == 0 {
+		return fmt.Errorf("container %d not found", start)
+	}
+	container := resp.Containers[0]
+
+	return cmd.db.Transaction(func(db *store.DB) error {
+		switch alias {
+		case "C":
+			// C-chain blocks and transactions use the block time, so the range is
+			// selected by the height of the container block.
+			block, err := cvm.DecodeBlock(container.Bytes)
+			if err != nil {
+				return err
+			}
+			height := block.NumberU64()
+
+			if err := db.Reindex.RewindChainByHeight(chainID, height); err != nil {
+				return err
+			}
+			if err := rewindSyncStatus(db, chainID+"_evm", int64(height)-1); err != nil {
+				return err
+			}
+		case "P":
+			// Events are created from blocks, so the events worker is rewound
+			// to the first removed block.
+			block, err := db.Platform.FirstBlockSince(chainID, container.Timestamp)
+			if err != nil && err != store.ErrNotFound {
+				return err
+			}
+			blockFound := err == nil
+
+			if err := db.Reindex.RewindChainByTime(chainID, container.Timestamp); err != nil {
+				return err
+			}
+
+			if blockFound {
+				if err := db.Reindex.RewindEvents(chainID, block.Height); err != nil {
+					return err
+				}
+				if err := rewindSyncStatus(db, chainID+"_events", int64(block.Height)-1); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := db.Reindex.RewindChainByTime(chainID, container.Timestamp); err != nil {
+				return err
+			}
+		}
+
+		if err := rewindMessages(db, chainID, container); err != nil {
+			return err
+		}
+
+		return resetSyncStatus(db, chainID, start-1)
+	})
+}
+
+// rewindMessages rewinds the raw messages consumer of the chain to the message of the container
+func rewindMessages(db *store.DB, chainID string, container client.Container) error {
+	topic, err := db.RawMessages.GetTopicByChain(chainID)
+	if err != nil {
+		return err
+	}
+	if topic.ID == 0 {
+		return nil
+	}
+
+	msg, err := db.RawMessages.FirstMessageSince(topic.ID, container.ID, container.Timestamp)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if err := db.RawMessages.ResetProcessed(topic.ID, msg.ID); err != nil {
+		return err
+	}
+	return rewindSyncStatus(db, chainID+"_ipc", int64(msg.ID)-1)
+}
+
+// reindexEvm rewinds the C-chain evm worker to the given block height
+func (cmd ReindexCommand) reindexEvm(height int64) error {
+	chainID, err := cmd.rpc.Info.BlockchainID("C")
+	if err != nil {
+		return err
+	}
+
+	return cmd.db.Transaction(func(db *store.DB) error {
+		if err := db.Reindex.RewindEvmData(chainID, uint64(height)); err != nil {
+			return err
+		}
+		return resetSyncStatus(db, chainID+"_evm", height-1)
+	})
+}
+
+// reindexEvents rewinds the P-chain events worker to the given block height
+func (cmd ReindexCommand) reindexEvents(height int64) error {
+	chainID, err := cmd.rpc.Info.BlockchainID("P")
+	if err != nil {
+		return err
+	}
+
+	return cmd.db.Transaction(func(db *store.DB) error {
+		if err := db.Reindex.RewindEvents(chainID, uint64(height)); err != nil {
+			return err
+		}
+		return resetSyncStatus(db, chainID+"_events", height-1)
+	})
+}
+
+// resetSyncStatus sets the last indexed ID of the sync status
+func resetSyncStatus(db *store.DB, id string, indexID int64) error {
+	status, err := db.Platform.GetSyncStatus(id)
+	if err != nil {
+		if err != store.ErrNotFound {
+			return err
+		}
+		status = &model.SyncStatus{ID: id}
+	}
+
+	status.IndexID = indexID
+	return db.Platform.UpdateSyncStatus(status)
+}
+
+// rewindSyncStatus moves the dependent sync status back if it's past the given ID
+func rewindSyncStatus(db *store.DB, id string, indexID int64) error {
+	status, err := db.Platform.GetSyncStatus(id)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if status.IndexID <= indexID {
+		return nil
+	}
+
+	status.IndexID = indexID
+	return db.Platform.UpdateSyncStatus(status)
+}
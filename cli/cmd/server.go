@@ -8,24 +8,26 @@ import (
 )
 
 type ServerCommand struct {
-	db     *store.DB
-	addr   string
-	logger *logrus.Logger
-	rpc    *client.Client
+	db         *store.DB
+	addr       string
+	adminToken string
+	logger     *logrus.Logger
+	rpc        *client.Client
 }
 
-func NewServerCommand(db *store.DB, addr string, logger *logrus.Logger, rpc *client.Client) ServerCommand {
+func NewServerCommand(db *store.DB, addr string, adminToken string, logger *logrus.Logger, rpc *client.Client) ServerCommand {
 	return ServerCommand{
-		db:     db,
-		addr:   addr,
-		logger: logger,
-		rpc:    rpc,
+		db:         db,
+		addr:       addr,
+		adminToken: adminToken,
+		logger:     logger,
+		rpc:        rpc,
 	}
 }
 
 func (cmd ServerCommand) Run() error {
 	cmd.logger.Info("starting http server on ", cmd.addr)
 
-	server := api.NewServer(cmd.db, cmd.rpc, cmd.logger)
+	server := api.NewServer(cmd.db, cmd.rpc, cmd.logger, cmd.adminToken)
 	return server.Run(cmd.addr)
 }
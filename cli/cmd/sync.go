@@ -14,13 +14,17 @@ import (
 	"github.com/figment-networks/avalanche-indexer/indexer/cvm"
 	"github.com/figment-networks/avalanche-indexer/indexer/evm"
 	"github.com/figment-networks/avalanche-indexer/indexer/pvm"
+	"github.com/figment-networks/avalanche-indexer/indexer/rewards"
+	"github.com/figment-networks/avalanche-indexer/indexer/shared"
 	"github.com/figment-networks/avalanche-indexer/model"
 	"github.com/figment-networks/avalanche-indexer/store"
 )
 
 type SyncCommand struct {
-	networkID  uint32
-	evmChainID uint32
+	networkID     uint32
+	evmChainID    uint32
+	failurePolicy shared.FailurePolicy
+	traceConfig   evm.TraceConfig
 
 	logger *logrus.Logger
 	db     *store.DB
@@ -31,13 +35,15 @@ type syncWorker interface {
 	ProcessMessage(*model.RawMessage) error
 }
 
-func NewSyncCommand(logger *logrus.Logger, db *store.DB, rpc *client.Client, networkID uint32, evmChainID uint32) SyncCommand {
+func NewSyncCommand(logger *logrus.Logger, db *store.DB, rpc *client.Client, networkID uint32, evmChainID uint32, failurePolicy shared.FailurePolicy, traceConfig evm.TraceConfig) SyncCommand {
 	return SyncCommand{
-		networkID:  networkID,
-		evmChainID: evmChainID,
-		logger:     logger,
-		db:         db,
-		rpc:        rpc,
+		networkID:     networkID,
+		evmChainID:    evmChainID,
+		failurePolicy: failurePolicy,
+		traceConfig:   traceConfig,
+		logger:        logger,
+		db:            db,
+		rpc:           rpc,
 	}
 }
 
@@ -91,17 +97,23 @@ func (cmd SyncCommand) Run() error {
 		return err
 	}
 
-	avmWorker := avm.NewWorker(&cmd.rpc.Index, cmd.db, codec.AVM, xID, assetID.String())
-	pvmWorker := pvm.NewWorker(&cmd.rpc.Index, cmd.db, codec.PVM, pID, assetID.String())
-	cvmWorker := cvm.NewWorker(cmd.db, codec.EVM, &cmd.rpc.Index, &cmd.rpc.Evm, cID, assetID.String(), big.NewInt(int64(cmd.evmChainID)))
+	xSource := shared.NewIndexSource(&cmd.rpc.Index, avm.ContainerType, xID)
+	pSource := shared.NewIndexSource(&cmd.rpc.Index, pvm.ContainerType, pID)
+	cSource := shared.NewIndexSource(&cmd.rpc.Index, cvm.ContainerType, cID)
+
+	avmWorker := avm.NewWorker(xSource, cmd.db, codec.AVM, xID, assetID.String(), cmd.failurePolicy)
+	pvmWorker := pvm.NewWorker(pSource, cmd.db, codec.PVM, pID, assetID.String(), cmd.failurePolicy)
+	cvmWorker := cvm.NewWorker(cmd.db, codec.EVM, cSource, &cmd.rpc.Evm, cID, assetID.String(), big.NewInt(int64(cmd.evmChainID)), cmd.failurePolicy)
 	pblocksWorker := blocks.NewWorker(cmd.db, cmd.rpc, cmd.logger, pID)
-	evmWorker := evm.NewWorker(cmd.db, cmd.rpc, cmd.logger, cID)
+	evmWorker := evm.NewWorker(cmd.db, cmd.rpc, cmd.logger, cID, cmd.traceConfig)
+	rewardsWorker := rewards.NewWorker(cmd.db, cmd.rpc, cmd.logger, pID)
 
 	return runChain(
 		avmWorker.Run,
 		pvmWorker.Run,
 		cvmWorker.Run,
 		evmWorker.Run,
+		rewardsWorker.Run,
 		pblocksWorker.Run,
 	)
 }
@@ -20,6 +20,8 @@ import (
 	"github.com/figment-networks/avalanche-indexer/indexer/cvm"
 	"github.com/figment-networks/avalanche-indexer/indexer/evm"
 	"github.com/figment-networks/avalanche-indexer/indexer/pvm"
+	"github.com/figment-networks/avalanche-indexer/indexer/rewards"
+	"github.com/figment-networks/avalanche-indexer/indexer/shared"
 	"github.com/figment-networks/avalanche-indexer/model"
 	"github.com/figment-networks/avalanche-indexer/store"
 )
@@ -32,8 +34,12 @@ type WorkerCommand struct {
 	purgeInterval  time.Duration
 	archiverConfig string
 
-	networkID  uint32
-	evmChainID uint32
+	networkID     uint32
+	evmChainID    uint32
+	source        string
+	archiveDir    string
+	failurePolicy shared.FailurePolicy
+	traceConfig   evm.TraceConfig
 }
 
 func NewWorkerCommand(
@@ -44,6 +50,10 @@ func NewWorkerCommand(
 	purgeInterval time.Duration,
 	networkID uint32,
 	evmChainID uint32,
+	source string,
+	archiveDir string,
+	failurePolicy shared.FailurePolicy,
+	traceConfig evm.TraceConfig,
 ) WorkerCommand {
 	return WorkerCommand{
 		db:            db,
@@ -53,6 +63,10 @@ func NewWorkerCommand(
 		purgeInterval: purgeInterval,
 		networkID:     networkID,
 		evmChainID:    evmChainID,
+		source:        source,
+		archiveDir:    archiveDir,
+		failurePolicy: failurePolicy,
+		traceConfig:   traceConfig,
 	}
 }
 
@@ -119,11 +133,27 @@ func (cmd WorkerCommand) startChainWorkers(ctx context.Context) error {
 	cmd.db.Platform.CreateChain(&model.Chain{ChainID: xID, Name: "X"})
 	cmd.db.Platform.CreateChain(&model.Chain{ChainID: cID, Name: "C"})
 
-	avmWorker := avm.NewWorker(&cmd.rpc.Index, cmd.db, codec.AVM, xID, assetID.String())
-	pvmWorker := pvm.NewWorker(&cmd.rpc.Index, cmd.db, codec.PVM, pID, assetID.String())
-	cvmWorker := cvm.NewWorker(cmd.db, codec.EVM, &cmd.rpc.Index, &cmd.rpc.Evm, cID, assetID.String(), big.NewInt(int64(cmd.evmChainID)))
+	xSource, err := shared.NewContainerSource(cmd.source, cmd.rpc, cmd.db, avm.ContainerType, xID, cmd.archiveDir)
+	if err != nil {
+		return err
+	}
+
+	pSource, err := shared.NewContainerSource(cmd.source, cmd.rpc, cmd.db, pvm.ContainerType, pID, cmd.archiveDir)
+	if err != nil {
+		return err
+	}
+
+	cSource, err := shared.NewContainerSource(cmd.source, cmd.rpc, cmd.db, cvm.ContainerType, cID, cmd.archiveDir)
+	if err != nil {
+		return err
+	}
+
+	avmWorker := avm.NewWorker(xSource, cmd.db, codec.AVM, xID, assetID.String(), cmd.failurePolicy)
+	pvmWorker := pvm.NewWorker(pSource, cmd.db, codec.PVM, pID, assetID.String(), cmd.failurePolicy)
+	cvmWorker := cvm.NewWorker(cmd.db, codec.EVM, cSource, &cmd.rpc.Evm, cID, assetID.String(), big.NewInt(int64(cmd.evmChainID)), cmd.failurePolicy)
 	pblocksWorker := blocks.NewWorker(cmd.db, cmd.rpc, cmd.logger, pID)
-	evmWorker := evm.NewWorker(cmd.db, cmd.rpc, cmd.logger, cID)
+	evmWorker := evm.NewWorker(cmd.db, cmd.rpc, cmd.logger, cID, cmd.traceConfig)
+	rewardsWorker := rewards.NewWorker(cmd.db, cmd.rpc, cmd.logger, pID)
 
 	runWorkerFuncs(
 		ctx,
@@ -132,6 +162,7 @@ func (cmd WorkerCommand) startChainWorkers(ctx context.Context) error {
 		cvmWorker.Start,
 		evmWorker.Start,
 		pblocksWorker.Start,
+		rewardsWorker.Start,
 	)
 
 	return nil
@@ -3,8 +3,14 @@ package cli
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"time"
+
+	"github.com/figment-networks/avalanche-indexer/archive"
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/indexer/evm"
+	"github.com/figment-networks/avalanche-indexer/indexer/shared"
 )
 
 type Config struct {
@@ -16,6 +22,7 @@ type Config struct {
 	DatabaseURL       string `json:"database_url"`
 	RPCEndpoint       string `json:"rpc_endpoint"`
 	ServerAddr        string `json:"server_addr"`
+	AdminToken        string `json:"admin_token"`
 	LogLevel          string `json:"log_level"`
 	LogSQL            bool   `json:"log_sql"`
 	SyncEnabled       bool   `json:"sync_enabled"`
@@ -25,6 +32,23 @@ type Config struct {
 	PurgePeriod       string `json:"purge_period"`
 	Ap5ActivationTime int64  `json:"ap5_activation_time"`
 
+	IPCRoot      string   `json:"ipc_root"`
+	IPCChains    []string `json:"ipc_chains"`
+	WorkerSource string   `json:"worker_source"`
+	ArchiveDir   string   `json:"archive_dir"`
+
+	ArchiveFormat    string `json:"archive_format"`
+	ArchiveChunkSize int    `json:"archive_chunk_size"`
+
+	FailurePolicy      string `json:"failure_policy"`
+	FailureMaxAttempts int    `json:"failure_max_attempts"`
+
+	EvmTracer             string `json:"evm_tracer"`
+	EvmTraceTimeout       string `json:"evm_trace_timeout"`
+	EvmTraceMode          string `json:"evm_trace_mode"`
+	EvmTraceConcurrency   int    `json:"evm_trace_concurrency"`
+	EvmTraceSkipTransfers bool   `json:"evm_trace_skip_transfers"`
+
 	syncInterval  time.Duration
 	purgeInterval time.Duration
 	ap5time       *time.Time
@@ -77,6 +101,58 @@ func (c *Config) Validate() error {
 	}
 	c.purgeInterval = purgeDur
 
+	switch c.WorkerSource {
+	case "":
+		c.WorkerSource = shared.SourceIndex
+	case shared.SourceIndex, shared.SourceIPC:
+	case shared.SourceArchive:
+		if c.ArchiveDir == "" {
+			return errors.New("archive dir is required for archive worker source")
+		}
+	default:
+		return fmt.Errorf("invalid worker source: %q", c.WorkerSource)
+	}
+
+	if c.ArchiveFormat == "" {
+		c.ArchiveFormat = archive.FormatJSONL
+	}
+	if err := archive.ValidateFormat(c.ArchiveFormat); err != nil {
+		return err
+	}
+	if c.ArchiveChunkSize == 0 {
+		c.ArchiveChunkSize = 10000
+	}
+
+	if c.FailurePolicy == "" {
+		c.FailurePolicy = shared.FailurePolicyHalt
+	}
+	if err := c.GetFailurePolicy().Validate(); err != nil {
+		return err
+	}
+
+	switch c.EvmTracer {
+	case "":
+		c.EvmTracer = client.TracerJS
+	case client.TracerJS, client.TracerCall:
+	default:
+		return fmt.Errorf("invalid evm tracer: %q", c.EvmTracer)
+	}
+	if c.EvmTraceTimeout == "" {
+		c.EvmTraceTimeout = "180s"
+	}
+	if _, err := time.ParseDuration(c.EvmTraceTimeout); err != nil {
+		return err
+	}
+	if c.EvmTraceMode == "" {
+		c.EvmTraceMode = evm.TraceModeTransaction
+	}
+	if c.EvmTraceConcurrency == 0 {
+		c.EvmTraceConcurrency = 50
+	}
+	if err := c.GetEvmTraceConfig().Validate(); err != nil {
+		return err
+	}
+
 	if c.Ap5ActivationTime > 0 {
 		ap5time := time.Unix(c.Ap5ActivationTime, 0)
 		c.ap5time = &ap5time
@@ -93,6 +169,21 @@ func (c *Config) GetPurgeInterval() time.Duration {
 	return c.purgeInterval
 }
 
+func (c *Config) GetFailurePolicy() shared.FailurePolicy {
+	return shared.FailurePolicy{
+		Mode:        c.FailurePolicy,
+		MaxAttempts: c.FailureMaxAttempts,
+	}
+}
+
+func (c *Config) GetEvmTraceConfig() evm.TraceConfig {
+	return evm.TraceConfig{
+		Mode:          c.EvmTraceMode,
+		Concurrency:   c.EvmTraceConcurrency,
+		SkipTransfers: c.EvmTraceSkipTransfers,
+	}
+}
+
 func (c *Config) GetAP5ActivationTime() *time.Time {
 	return c.ap5time
 }
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+)
+
+var (
+	// EIP-1967 implementation slot: keccak256("eip1967.proxy.implementation") - 1
+	eip1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+	// EIP-1822 (UUPS) implementation slot: keccak256("PROXIABLE")
+	eip1822ImplementationSlot = common.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7")
+)
+
+// ProxyImplementation is the implementation address read from a proxy storage slot
+type ProxyImplementation struct {
+	Type    string
+	Address common.Address
+}
+
+// ContractCode returns the runtime bytecode of the contract at the given block.
+// It fails on nodes without the historical state of the block.
+func (c *EvmClient) ContractCode(ctx context.Context, address common.Address, block *big.Int) ([]byte, error) {
+	return c.CodeAt(ctx, address, block)
+}
+
+// ProxyImplementation reads the EIP-1967 and EIP-1822 implementation slots of the contract
+// and returns nil when neither is set
+func (c *EvmClient) ProxyImplementation(ctx context.Context, address common.Address, block *big.Int) (*ProxyImplementation, error) {
+	slots := []struct {
+		proxyType string
+		slot      common.Hash
+	}{
+		{model.ProxyTypeEIP1967, eip1967ImplementationSlot},
+		{model.ProxyTypeEIP1822, eip1822ImplementationSlot},
+	}
+
+	for _, s := range slots {
+		value, err := c.StorageAt(ctx, address, s.slot, block)
+		if err != nil {
+			return nil, err
+		}
+
+		impl := common.BytesToAddress(value)
+		if impl != (common.Address{}) {
+			return &ProxyImplementation{Type: s.proxyType, Address: impl}, nil
+		}
+	}
+
+	return nil, nil
+}
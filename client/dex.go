@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// Uniswap-V2 pair method selectors
+	selectorFactory = common.FromHex("0xc45a0155")
+	selectorToken0  = common.FromHex("0x0dfe1681")
+	selectorToken1  = common.FromHex("0xd21220a7")
+)
+
+// PairInfo contains the tokens and factory of a Uniswap-V2-style pair
+type PairInfo struct {
+	Factory *common.Address
+	Token0  common.Address
+	Token1  common.Address
+}
+
+// PairInfo fetches the pair tokens and factory, it returns nil if the contract
+// does not implement the pair interface
+func (c *EvmClient) PairInfo(ctx context.Context, address string) (*PairInfo, error) {
+	contract := common.HexToAddress(address)
+
+	token0, err := c.callToken(ctx, contract, selectorToken0)
+	if err != nil || len(token0) != 32 {
+		return nil, err
+	}
+
+	token1, err := c.callToken(ctx, contract, selectorToken1)
+	if err != nil || len(token1) != 32 {
+		return nil, err
+	}
+
+	result := &PairInfo{
+		Token0: common.BytesToAddress(token0),
+		Token1: common.BytesToAddress(token1),
+	}
+
+	factory, err := c.callToken(ctx, contract, selectorFactory)
+	if err != nil {
+		return nil, err
+	}
+	if len(factory) == 32 {
+		addr := common.BytesToAddress(factory)
+		result.Factory = &addr
+	}
+
+	return result, nil
+}
@@ -2,11 +2,23 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 )
 
+const (
+	// TracerJS is the bundled JavaScript call tracer
+	TracerJS = "js"
+
+	// TracerCall is the native call tracer built into the node
+	TracerCall = "callTracer"
+)
+
 var (
 	tracerTimeout = "180s"
 )
@@ -17,6 +29,36 @@ type EvmClient struct {
 	traceConfig *tracers.TraceConfig
 }
 
+// blockTraceResult is a single transaction trace returned by the block tracing methods
+type blockTraceResult struct {
+	Result *Call  `json:"result"`
+	Error  string `json:"error"`
+}
+
+// SetTracer changes the tracer used for transaction and block traces.
+// The request timeout is raised to match the tracer timeout when needed.
+func (c *EvmClient) SetTracer(tracer string, timeout string) error {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return err
+	}
+
+	switch tracer {
+	case TracerJS:
+		c.traceConfig = &tracers.TraceConfig{Timeout: &timeout, Tracer: &jsTracer}
+	case TracerCall:
+		c.traceConfig = &tracers.TraceConfig{Timeout: &timeout, Tracer: &tracer}
+	default:
+		return fmt.Errorf("invalid tracer: %q", tracer)
+	}
+
+	if c.rpc.client.Timeout < duration {
+		c.rpc.client.Timeout = duration
+	}
+
+	return nil
+}
+
 func (c *EvmClient) TraceTransaction(ctx context.Context, hash string) (*Call, error) {
 	result := &Call{}
 	args := []interface{}{hash, c.traceConfig}
@@ -28,3 +70,24 @@ func (c *EvmClient) TraceTransaction(ctx context.Context, hash string) (*Call, e
 
 	return result, nil
 }
+
+// TraceBlockByNumber returns traces of all block transactions in the block order
+func (c *EvmClient) TraceBlockByNumber(ctx context.Context, number *big.Int) ([]*Call, error) {
+	results := []blockTraceResult{}
+	args := []interface{}{hexutil.EncodeBig(number), c.traceConfig}
+
+	err := c.rpc.call("debug_traceBlockByNumber", args, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]*Call, len(results))
+	for idx, result := range results {
+		if result.Error != "" || result.Result == nil {
+			return nil, fmt.Errorf("trace of transaction %d in block %s failed: %s", idx, number, result.Error)
+		}
+		traces[idx] = result.Result
+	}
+
+	return traces, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// Error(string) and Panic(uint256) selectors
+	selectorError = common.FromHex("0x08c379a0")
+	selectorPanic = common.FromHex("0x4e487b71")
+
+	// Solidity panic codes
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert failed",
+		0x11: "arithmetic overflow or underflow",
+		0x12: "division or modulo by zero",
+		0x21: "invalid enum value",
+		0x22: "invalid storage byte array encoding",
+		0x31: "pop on empty array",
+		0x32: "array index out of bounds",
+		0x41: "out of memory",
+		0x51: "call to uninitialized function",
+	}
+)
+
+// DecodeRevertReason returns the message of a standard Error(string) or Panic(uint256)
+// revert payload. Custom errors and empty payloads return an empty string.
+func DecodeRevertReason(data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+
+	selector, payload := data[:4], data[4:]
+
+	switch {
+	case bytes.Equal(selector, selectorError):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return ""
+		}
+		if str := sanitizeString(reason); str != nil {
+			return *str
+		}
+		return ""
+	case bytes.Equal(selector, selectorPanic):
+		if len(payload) != 32 {
+			return ""
+		}
+		code := new(big.Int).SetBytes(payload)
+		if reason, ok := panicReasons[code.Uint64()]; ok && code.IsUint64() {
+			return fmt.Sprintf("panic: %s (0x%02x)", reason, code.Uint64())
+		}
+		return fmt.Sprintf("panic: unknown code (0x%s)", code.Text(16))
+	}
+
+	return ""
+}
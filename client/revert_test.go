@@ -0,0 +1,60 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRevertReason(t *testing.T) {
+	errorPayload, err := stringArguments.Pack("insufficient balance")
+	require.NoError(t, err)
+
+	examples := []struct {
+		name   string
+		data   []byte
+		reason string
+	}{
+		{"empty", nil, ""},
+		{"short", common.FromHex("0x08c379"), ""},
+		{"error", concat(selectorError, errorPayload), "insufficient balance"},
+		{"error truncated", concat(selectorError, errorPayload[:40]), ""},
+		{"panic", concat(selectorPanic, common.LeftPadBytes([]byte{0x11}, 32)), "panic: arithmetic overflow or underflow (0x11)"},
+		{"panic unknown", concat(selectorPanic, common.LeftPadBytes([]byte{0x99}, 32)), "panic: unknown code (0x99)"},
+		{"custom error", common.FromHex("0xcf4791810000000000000000000000000000000000000000000000000000000000000001"), ""},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			assert.Equal(t, ex.reason, DecodeRevertReason(ex.data))
+		})
+	}
+}
+
+func TestCallUnmarshalRevertReason(t *testing.T) {
+	errorPayload, err := stringArguments.Pack("not owner")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(map[string]interface{}{
+		"type":   "CALL",
+		"input":  "0xa9059cbb",
+		"output": hexutil.Encode(concat(selectorError, errorPayload)),
+		"error":  "execution reverted",
+	})
+	require.NoError(t, err)
+
+	call := &Call{}
+	require.NoError(t, json.Unmarshal(data, call))
+	assert.True(t, call.Revert)
+	assert.Equal(t, "not owner", call.RevertReason)
+	assert.Equal(t, common.FromHex("0xa9059cbb"), []byte(call.Input))
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/coreth/interfaces"
+	corethRPC "github.com/ava-labs/coreth/rpc"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// JSON-RPC error code of calls reverted with a revert reason
+	errCodeReverted = 3
+)
+
+var (
+	// ERC-20 metadata method selectors
+	selectorName     = common.FromHex("0x06fdde03")
+	selectorSymbol   = common.FromHex("0x95d89b41")
+	selectorDecimals = common.FromHex("0x313ce567")
+
+	stringArguments abi.Arguments
+)
+
+func init() {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	stringArguments = abi.Arguments{{Type: stringType}}
+}
+
+// TokenMetadata contains the optional ERC-20 metadata fields of a token contract
+type TokenMetadata struct {
+	Name     *string
+	Symbol   *string
+	Decimals *int
+}
+
+// TokenMetadata fetches the token name, symbol and decimals of the contract.
+// Fields not implemented by the contract are left empty.
+func (c *EvmClient) TokenMetadata(ctx context.Context, address string) (*TokenMetadata, error) {
+	contract := common.HexToAddress(address)
+	result := &TokenMetadata{}
+
+	name, err := c.callToken(ctx, contract, selectorName)
+	if err != nil {
+		return nil, err
+	}
+	result.Name = decodeTokenString(name)
+
+	symbol, err := c.callToken(ctx, contract, selectorSymbol)
+	if err != nil {
+		return nil, err
+	}
+	result.Symbol = decodeTokenString(symbol)
+
+	decimals, err := c.callToken(ctx, contract, selectorDecimals)
+	if err != nil {
+		return nil, err
+	}
+	if len(decimals) == 32 {
+		val := new(big.Int).SetBytes(decimals)
+		if val.IsInt64() && val.Int64() <= 255 {
+			num := int(val.Int64())
+			result.Decimals = &num
+		}
+	}
+
+	return result, nil
+}
+
+// callToken calls the contract method at the latest block, reverted calls return no data
+func (c *EvmClient) callToken(ctx context.Context, contract common.Address, selector []byte) ([]byte, error) {
+	data, err := c.CallContract(ctx, interfaces.CallMsg{To: &contract, Data: selector}, nil)
+	if err != nil {
+		if isExecutionReverted(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// isExecutionReverted returns true when the call failed because the contract reverted
+func isExecutionReverted(err error) bool {
+	var rpcErr corethRPC.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return rpcErr.ErrorCode() == errCodeReverted || strings.Contains(rpcErr.Error(), "execution reverted")
+}
+
+// decodeTokenString decodes an ABI string, or a bytes32 value used by some older tokens
+func decodeTokenString(data []byte) *string {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if values, err := stringArguments.Unpack(data); err == nil && len(values) == 1 {
+		if str, ok := values[0].(string); ok {
+			return sanitizeString(str)
+		}
+		return nil
+	}
+
+	if len(data) == 32 {
+		return sanitizeString(string(bytes.TrimRight(data, "\x00")))
+	}
+
+	return nil
+}
+
+// sanitizeString removes characters that can't be stored in a text column
+func sanitizeString(str string) *string {
+	str = strings.ToValidUTF8(strings.ReplaceAll(str, "\x00", ""), "")
+	if str == "" {
+		return nil
+	}
+	return &str
+}
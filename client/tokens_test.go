@@ -0,0 +1,38 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRPCError struct {
+	code    int
+	message string
+}
+
+func (e testRPCError) Error() string  { return e.message }
+func (e testRPCError) ErrorCode() int { return e.code }
+
+func TestIsExecutionReverted(t *testing.T) {
+	examples := []struct {
+		name     string
+		err      error
+		reverted bool
+	}{
+		{"revert reason", testRPCError{3, "execution reverted: not allowed"}, true},
+		{"plain revert", testRPCError{-32000, "execution reverted"}, true},
+		{"wrapped revert", fmt.Errorf("call failed: %w", testRPCError{3, "execution reverted"}), true},
+		{"missing state", testRPCError{-32000, "missing trie node"}, false},
+		{"rate limited", testRPCError{-32005, "limit exceeded"}, false},
+		{"transport", errors.New("connection refused"), false},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			assert.Equal(t, ex.reverted, isExecutionReverted(ex.err))
+		})
+	}
+}
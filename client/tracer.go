@@ -6,6 +6,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/figment-networks/avalanche-indexer/model"
 )
 
 type Call struct {
@@ -17,7 +19,12 @@ type Call struct {
 	GasUsed *hexutil.Big   `json:"gasUsed"`
 	Revert  bool           `json:"revert"`
 	Error   string         `json:"error,omitempty"`
+	Input   hexutil.Bytes  `json:"input,omitempty"`
+	Output  hexutil.Bytes  `json:"output,omitempty"`
 	Calls   []*Call        `json:"calls"`
+
+	RevertReason string             `json:"revertReason,omitempty"`
+	Decoded      *model.DecodedCall `json:"decoded,omitempty"`
 }
 
 type customCall struct {
@@ -29,10 +36,13 @@ type customCall struct {
 	GasUsed *hexutil.Big   `json:"gasUsed"`
 	Revert  bool           `json:"revert"`
 	Error   string         `json:"error"`
+	Input   hexutil.Bytes  `json:"input,omitempty"`
+	Output  hexutil.Bytes  `json:"output,omitempty"`
 	Calls   []*Call        `json:"calls"`
 }
 
 type FlatCall struct {
+	Path    []int          `json:"path"`
 	Type    string         `json:"type"`
 	From    common.Address `json:"from"`
 	To      common.Address `json:"to"`
@@ -41,6 +51,8 @@ type FlatCall struct {
 	GasUsed *big.Int       `json:"gasUsed"`
 	Revert  bool           `json:"revert"`
 	Error   string         `json:"error"`
+
+	RevertReason string `json:"revertReason"`
 }
 
 func (t *Call) Flatten() *FlatCall {
@@ -53,6 +65,8 @@ func (t *Call) Flatten() *FlatCall {
 		GasUsed: t.GasUsed.ToInt(),
 		Revert:  t.Revert,
 		Error:   t.Error,
+
+		RevertReason: t.RevertReason,
 	}
 }
 
@@ -66,6 +80,8 @@ func (t *Call) UnmarshalJSON(input []byte) error {
 	t.From = dec.From
 	t.To = dec.To
 	t.Error = dec.Error
+	t.Input = dec.Input
+	t.Output = dec.Output
 	t.Calls = dec.Calls
 
 	if dec.Value != nil {
@@ -89,15 +105,24 @@ func (t *Call) UnmarshalJSON(input []byte) error {
 	// Any error surfaced by the decoder means that the transaction has reverted.
 	if dec.Error != "" {
 		t.Revert = true
+		t.RevertReason = DecodeRevertReason(dec.Output)
 	}
 
 	return nil
 }
 
-func FlattenTraces(data *Call, flattened []*FlatCall) []*FlatCall {
-	results := append(flattened, data.Flatten())
+// FlattenTraces returns the call tree in execution order. Each call's path holds
+// its child positions starting from the top level call, which has an empty path.
+func FlattenTraces(data *Call) []*FlatCall {
+	return flattenTraces(data, []int{}, nil)
+}
+
+func flattenTraces(data *Call, path []int, results []*FlatCall) []*FlatCall {
+	flat := data.Flatten()
+	flat.Path = path
+	results = append(results, flat)
 
-	for _, child := range data.Calls {
+	for idx, child := range data.Calls {
 		// Ensure all children of a reverted call are also reverted!
 		if data.Revert {
 			child.Revert = true
@@ -108,8 +133,11 @@ func FlattenTraces(data *Call, flattened []*FlatCall) []*FlatCall {
 			}
 		}
 
-		children := FlattenTraces(child, flattened)
-		results = append(results, children...)
+		childPath := make([]int, len(path)+1)
+		copy(childPath, path)
+		childPath[len(path)] = idx
+
+		results = flattenTraces(child, childPath, results)
 	}
 
 	return results
@@ -0,0 +1,239 @@
+package decoder
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/model"
+)
+
+// DecodeInput decodes the call input data sent to the contract
+func (r *Registry) DecodeInput(to string, input []byte) *model.DecodedCall {
+	if len(input) < 4 {
+		return nil
+	}
+
+	if contract := r.contractABI(to); contract != nil {
+		if method, err := contract.MethodById(input[:4]); err == nil {
+			if decoded := decodeMethod(method, input[4:]); decoded != nil {
+				return decoded
+			}
+		}
+	}
+
+	var selector [4]byte
+	copy(selector[:], input[:4])
+
+	for idx := range bundled.methods[selector] {
+		if decoded := decodeMethod(&bundled.methods[selector][idx], input[4:]); decoded != nil {
+			return decoded
+		}
+	}
+
+	return nil
+}
+
+// DecodeLog decodes the event emitted by the contract
+func (r *Registry) DecodeLog(address string, topics []common.Hash, data []byte) *model.DecodedEvent {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	if contract := r.contractABI(address); contract != nil {
+		if event, err := contract.EventByID(topics[0]); err == nil {
+			if decoded := decodeEvent(event, topics[1:], data); decoded != nil {
+				return decoded
+			}
+		}
+	}
+
+	// Events sharing the signature, like ERC-20 and ERC-721 transfers, differ by indexed arguments
+	for idx := range bundled.events[topics[0]] {
+		if decoded := decodeEvent(&bundled.events[topics[0]][idx], topics[1:], data); decoded != nil {
+			return decoded
+		}
+	}
+
+	return nil
+}
+
+// DecodeLogHex decodes the event from hex encoded topics and data
+func (r *Registry) DecodeLogHex(address string, topics []string, data string) *model.DecodedEvent {
+	hashes := make([]common.Hash, len(topics))
+	for idx, topic := range topics {
+		hashes[idx] = common.HexToHash(topic)
+	}
+	return r.DecodeLog(address, hashes, common.FromHex(data))
+}
+
+// DecodeLogRecord decodes the stored log record
+func (r *Registry) DecodeLogRecord(record *model.EvmLogRecord) *model.DecodedEvent {
+	topics := []string{}
+	for _, topic := range []*string{record.Topic0, record.Topic1, record.Topic2, record.Topic3} {
+		if topic == nil {
+			break
+		}
+		topics = append(topics, *topic)
+	}
+	return r.DecodeLogHex(record.Address, topics, record.Data)
+}
+
+// DecodeTrace decodes inputs of the call and all of its nested calls
+func (r *Registry) DecodeTrace(call *client.Call) {
+	if call == nil {
+		return
+	}
+
+	call.Decoded = r.DecodeInput(call.To.Hex(), call.Input)
+	for _, child := range call.Calls {
+		r.DecodeTrace(child)
+	}
+}
+
+func decodeMethod(method *abi.Method, data []byte) *model.DecodedCall {
+	values, err := method.Inputs.Unpack(data)
+	if err != nil || len(values) != len(method.Inputs) {
+		return nil
+	}
+
+	args := make([]model.DecodedArg, len(method.Inputs))
+	for idx, input := range method.Inputs {
+		args[idx] = model.DecodedArg{
+			Name:  argName(input, idx),
+			Type:  input.Type.String(),
+			Value: formatValue(values[idx]),
+		}
+	}
+
+	return &model.DecodedCall{
+		Name:      method.RawName,
+		Signature: method.Sig,
+		Args:      args,
+	}
+}
+
+func decodeEvent(event *abi.Event, topics []common.Hash, data []byte) *model.DecodedEvent {
+	if event.Anonymous {
+		return nil
+	}
+
+	indexed := abi.Arguments{}
+	for idx, input := range event.Inputs {
+		if input.Indexed {
+			input.Name = argName(input, idx)
+			indexed = append(indexed, input)
+		}
+	}
+	if len(indexed) != len(topics) {
+		return nil
+	}
+
+	topicValues := map[string]interface{}{}
+	if err := abi.ParseTopicsIntoMap(topicValues, indexed, topics); err != nil {
+		return nil
+	}
+
+	values, err := event.Inputs.NonIndexed().Unpack(data)
+	if err != nil {
+		return nil
+	}
+
+	args := make([]model.DecodedArg, 0, len(event.Inputs))
+	for idx, input := range event.Inputs {
+		arg := model.DecodedArg{
+			Name:    argName(input, idx),
+			Type:    input.Type.String(),
+			Indexed: input.Indexed,
+		}
+
+		if input.Indexed {
+			arg.Value = formatValue(topicValues[arg.Name])
+		} else {
+			if len(values) == 0 {
+				return nil
+			}
+			arg.Value = formatValue(values[0])
+			values = values[1:]
+		}
+
+		args = append(args, arg)
+	}
+
+	return &model.DecodedEvent{
+		Name:      event.RawName,
+		Signature: event.Sig,
+		Args:      args,
+	}
+}
+
+func argName(arg abi.Argument, idx int) string {
+	if arg.Name != "" {
+		return arg.Name
+	}
+	return fmt.Sprintf("arg%d", idx)
+}
+
+// formatValue converts decoded values into a JSON friendly form, numbers are
+// rendered as decimal strings and binary values as hex strings
+func formatValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case bool, string:
+		return v
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%d", rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%d", rv.Uint())
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(data), rv)
+			return hexutil.Encode(data)
+		}
+		fallthrough
+	case reflect.Slice:
+		result := make([]interface{}, rv.Len())
+		for idx := 0; idx < rv.Len(); idx++ {
+			result[idx] = formatValue(rv.Index(idx).Interface())
+		}
+		return result
+	case reflect.Struct:
+		result := map[string]interface{}{}
+		for idx := 0; idx < rv.NumField(); idx++ {
+			field := rv.Type().Field(idx)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			result[name] = formatValue(rv.Field(idx).Interface())
+		}
+		return result
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return formatValue(rv.Elem().Interface())
+	}
+
+	return value
+}
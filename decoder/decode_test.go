@@ -0,0 +1,53 @@
+package decoder
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBundledTransfer(t *testing.T) {
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	method := bundled.methods[[4]byte{0xa9, 0x05, 0x9c, 0xbb}]
+	require.Len(t, method, 1)
+
+	input, err := method[0].Inputs.Pack(to, big.NewInt(1000))
+	require.NoError(t, err)
+
+	call := decodeMethod(&method[0], input)
+	require.NotNil(t, call)
+	assert.Equal(t, "transfer", call.Name)
+	assert.Equal(t, "transfer(address,uint256)", call.Signature)
+	assert.Equal(t, to.Hex(), call.Args[0].Value)
+	assert.Equal(t, "1000", call.Args[1].Value)
+
+	topics := []common.Hash{
+		common.BytesToHash(from.Bytes()),
+		common.BytesToHash(to.Bytes()),
+	}
+	events := bundled.events[common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")]
+	require.Len(t, events, 2)
+
+	// ERC-20 transfer has the value in data, ERC-721 transfer has the token ID as a topic
+	var erc20, erc721 int
+	for _, event := range events {
+		if decoded := decodeEvent(&event, topics, common.LeftPadBytes([]byte{0x05}, 32)); decoded != nil {
+			erc20++
+			assert.Equal(t, "value", decoded.Args[2].Name)
+			assert.Equal(t, "5", decoded.Args[2].Value)
+			assert.Equal(t, from.Hex(), decoded.Args[0].Value)
+		}
+		if decoded := decodeEvent(&event, append(topics, common.BigToHash(big.NewInt(7))), nil); decoded != nil {
+			erc721++
+			assert.Equal(t, "tokenId", decoded.Args[2].Name)
+			assert.Equal(t, "7", decoded.Args[2].Value)
+		}
+	}
+	assert.Equal(t, 1, erc20)
+	assert.Equal(t, 1, erc721)
+}
@@ -0,0 +1,138 @@
+package decoder
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+const (
+	// how long a contract ABI lookup is cached, including the missing ones
+	contractCacheTTL = time.Minute
+)
+
+var (
+	//go:embed signatures.json
+	signaturesData []byte
+
+	bundled *signatures
+)
+
+func init() {
+	var err error
+	if bundled, err = loadSignatures(signaturesData); err != nil {
+		panic(err)
+	}
+}
+
+// signatures holds well-known methods and events, grouped by selector and topic
+type signatures struct {
+	methods map[[4]byte][]abi.Method
+	events  map[common.Hash][]abi.Event
+}
+
+// Registry decodes EVM calls and logs using uploaded contract ABIs,
+// falling back to the bundled signature database
+type Registry struct {
+	db        *store.DB
+	lock      sync.Mutex
+	contracts map[string]contractEntry
+}
+
+type contractEntry struct {
+	abi      *abi.ABI
+	loadedAt time.Time
+}
+
+func NewRegistry(db *store.DB) *Registry {
+	return &Registry{
+		db:        db,
+		contracts: map[string]contractEntry{},
+	}
+}
+
+// ParseABI parses the JSON ABI definition
+func ParseABI(data string) (*abi.ABI, error) {
+	parsed, err := abi.JSON(strings.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// contractABI returns the uploaded ABI of the contract, if any
+func (r *Registry) contractABI(address string) *abi.ABI {
+	if address == "" {
+		return nil
+	}
+	address = normalizeAddress(address)
+
+	r.lock.Lock()
+	entry, ok := r.contracts[address]
+	r.lock.Unlock()
+
+	if ok && time.Since(entry.loadedAt) < contractCacheTTL {
+		return entry.abi
+	}
+
+	record, err := r.db.ContractAbis.Get(address)
+	if err != nil && err != store.ErrNotFound {
+		return nil
+	}
+
+	entry = contractEntry{loadedAt: time.Now()}
+	if err == nil {
+		if parsed, err := ParseABI(record.Abi); err == nil {
+			entry.abi = parsed
+		}
+	}
+
+	r.lock.Lock()
+	r.contracts[address] = entry
+	r.lock.Unlock()
+
+	return entry.abi
+}
+
+func loadSignatures(data []byte) (*signatures, error) {
+	fragments := []json.RawMessage{}
+	if err := json.Unmarshal(data, &fragments); err != nil {
+		return nil, err
+	}
+
+	result := &signatures{
+		methods: map[[4]byte][]abi.Method{},
+		events:  map[common.Hash][]abi.Event{},
+	}
+
+	// Fragments are parsed one by one, since overloaded names would be renamed otherwise
+	for _, fragment := range fragments {
+		parsed, err := abi.JSON(bytes.NewReader(append(append([]byte("["), fragment...), ']')))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, method := range parsed.Methods {
+			var selector [4]byte
+			copy(selector[:], method.ID)
+			result.methods[selector] = append(result.methods[selector], method)
+		}
+		for _, event := range parsed.Events {
+			result.events[event.ID] = append(result.events[event.ID], event)
+		}
+	}
+
+	return result, nil
+}
+
+func normalizeAddress(address string) string {
+	return common.HexToAddress(address).Hex()
+}
@@ -10,41 +10,45 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm"
 	"github.com/sirupsen/logrus"
 
-	"github.com/figment-networks/avalanche-indexer/client"
 	"github.com/figment-networks/avalanche-indexer/indexer/shared"
 	"github.com/figment-networks/avalanche-indexer/model"
 	"github.com/figment-networks/avalanche-indexer/store"
 )
 
 const (
-	containerType  = "X"
+	ContainerType  = "X"
 	fetchBatchSize = 256
 )
 
 type Worker struct {
-	chain       string
-	avaxAsset   string
-	status      *model.SyncStatus
-	codec       codec.Manager
-	store       *store.DB
-	indexClient *client.IndexClient
-	log         *logrus.Logger
+	chain     string
+	avaxAsset string
+	status    *model.SyncStatus
+	codec     codec.Manager
+	store     *store.DB
+	source    shared.ContainerSource
+	log       *logrus.Logger
+
+	failurePolicy shared.FailurePolicy
 }
 
 func NewWorker(
-	indexClient *client.IndexClient,
+	source shared.ContainerSource,
 	store *store.DB,
 	codec codec.Manager,
 	chain string,
 	asset string,
+	failurePolicy shared.FailurePolicy,
 ) Worker {
 	return Worker{
-		chain:       chain,
-		avaxAsset:   asset,
-		store:       store,
-		codec:       codec,
-		log:         logrus.StandardLogger(),
-		indexClient: indexClient,
+		chain:     chain,
+		avaxAsset: asset,
+		store:     store,
+		codec:     codec,
+		log:       logrus.StandardLogger(),
+		source:    source,
+
+		failurePolicy: failurePolicy,
 	}
 }
 
@@ -85,27 +89,38 @@ func (w *Worker) Start(ctx context.Context) {
 }
 
 func (w *Worker) Run() error {
-	status, err := shared.GetSyncStatus(w.indexClient, w.store, containerType, w.chain)
+	status, err := shared.GetSyncStatus(w.source, w.store)
 	if err != nil {
 		return err
 	}
 	w.status = status
 
+	if err := shared.RetryFailedContainers(w.status, w.source, w.store, w.failurePolicy, w.processMessage); err != nil {
+		return err
+	}
+
 	if w.status.AtTip() {
 		return nil
 	}
 
 	return shared.ProcessContainerRange(
 		w.status,
-		w.indexClient,
+		w.source,
 		w.store,
-		containerType,
 		fetchBatchSize,
-		w.ProcessMessage,
+		w.failurePolicy,
+		w.processMessage,
 	)
 }
 
+// ProcessMessage processes a single container within a database transaction
 func (w Worker) ProcessMessage(message *model.RawMessage) error {
+	return w.store.Transaction(func(db *store.DB) error {
+		return w.processMessage(db, message)
+	})
+}
+
+func (w Worker) processMessage(db *store.DB, message *model.RawMessage) error {
 	w.log.WithField("index", message.IndexID).Debug("processing message")
 
 	var (
@@ -161,11 +176,11 @@ func (w Worker) ProcessMessage(message *model.RawMessage) error {
 
 	updateTransactionTotals(tx, w.avaxAsset)
 
-	if err := w.store.Platform.CreateTransaction(tx); err != nil {
+	if err := db.Platform.CreateTransaction(tx); err != nil {
 		return err
 	}
 
-	if err := w.store.Platform.CreateTxOutputs(tx.Outputs); err != nil {
+	if err := db.Platform.CreateTxOutputs(tx.Outputs); err != nil {
 		return err
 	}
 
@@ -173,17 +188,17 @@ func (w Worker) ProcessMessage(message *model.RawMessage) error {
 	for idx, input := range tx.Inputs {
 		spentIDs[idx] = input.ID
 	}
-	if err := w.store.Platform.CreateTxInputs(spentIDs, tx.ID); err != nil {
+	if err := db.Platform.CreateTxInputs(spentIDs, tx.ID); err != nil {
 		return err
 	}
 
-	if err := w.store.Platform.MarkOutputsSpent(spentIDs, tx.ID, tx.Timestamp); err != nil {
+	if err := db.Platform.MarkOutputsSpent(spentIDs, tx.ID, tx.Timestamp); err != nil {
 		return err
 	}
 
 	switch tx.Type {
 	case model.TxTypeCreateAsset:
-		if err := w.createAssetFromTx(tx); err != nil {
+		if err := w.createAssetFromTx(db, tx); err != nil {
 			return err
 		}
 	}
@@ -191,7 +206,7 @@ func (w Worker) ProcessMessage(message *model.RawMessage) error {
 	return nil
 }
 
-func (w *Worker) createAssetFromTx(tx *model.Transaction) error {
+func (w *Worker) createAssetFromTx(db *store.DB, tx *model.Transaction) error {
 	asset := &model.Asset{
 		AssetID:      tx.Metadata.GetString("asset_id"),
 		Type:         tx.Metadata.GetString("asset_type"),
@@ -200,5 +215,5 @@ func (w *Worker) createAssetFromTx(tx *model.Transaction) error {
 		Denomination: tx.Metadata.GetInt("asset_denomination"),
 	}
 
-	return w.store.Assets.Create(asset)
+	return db.Assets.Create(asset)
 }
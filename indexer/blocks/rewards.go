@@ -0,0 +1,47 @@
+package blocks
+
+import (
+	"fmt"
+
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+// stakingReward is the outcome of a finished staking period
+type stakingReward struct {
+	rewarded  bool
+	amount    uint64
+	addresses []string
+}
+
+// stakingReward returns the reward of the staking transaction stored by the rewards worker.
+// Staking periods are only finished once their reward is processed, so the worker retries
+// the block until then.
+func (w Worker) stakingReward(stakingTxID string) (*stakingReward, error) {
+	reward, err := w.db.Rewards.Find(stakingTxID)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, fmt.Errorf("reward of staking tx %s is not processed yet", stakingTxID)
+		}
+		return nil, err
+	}
+
+	addresses, err := w.db.Rewards.OwnerAddresses(stakingTxID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &stakingReward{
+		rewarded:  reward.Rewarded,
+		addresses: addresses,
+	}
+
+	if reward.Rewarded {
+		outputs, err := w.db.Rewards.Outputs(stakingTxID)
+		if err != nil {
+			return nil, err
+		}
+		result.amount = ownerRewardAmount(outputs, addresses)
+	}
+
+	return result, nil
+}
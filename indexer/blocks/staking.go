@@ -0,0 +1,98 @@
+package blocks
+
+import (
+	"time"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+// updateStakingPeriod tracks the validator and delegator lifecycle of the block transaction
+func (w Worker) updateStakingPeriod(block *model.Block, tx *model.Transaction) error {
+	switch tx.Type {
+	case model.TxTypeAddValidator, model.TxTypeAddDelegator:
+		// Staking transactions are only added to the validator set when the proposal is committed
+		if block.Type != model.BlockTypeCommit {
+			return nil
+		}
+
+		period, err := w.initStakingPeriod(block, tx)
+		if err != nil {
+			return err
+		}
+		return w.db.Staking.CreatePeriod(period)
+	case model.TxTypeRewardValidator:
+		if tx.ReferenceTxID == nil {
+			return nil
+		}
+		return w.finishStakingPeriod(block, tx, *tx.ReferenceTxID)
+	default:
+		return nil
+	}
+}
+
+// finishStakingPeriod records the reward outcome of the staking period and the finished delegation
+func (w Worker) finishStakingPeriod(block *model.Block, tx *model.Transaction, id string) error {
+	period, err := w.db.Staking.GetPeriod(id)
+	if err != nil {
+		// Staking transactions added before the indexed range have no period
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	reward, err := w.stakingReward(id)
+	if err != nil {
+		return err
+	}
+
+	if err := w.db.Staking.FinishPeriod(id, tx.ID, block.Height, block.Timestamp, reward.rewarded); err != nil {
+		return err
+	}
+	if period.Type != model.StakingTypeDelegator {
+		return nil
+	}
+
+	return w.db.Delegators.Finish(id, tx.ID, block.Timestamp, reward.rewarded, reward.amount)
+}
+
+func (w Worker) initStakingPeriod(block *model.Block, tx *model.Transaction) (*model.StakingPeriod, error) {
+	startTime, err := time.Parse(time.RFC3339, tx.Metadata.GetString("start_time"))
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := time.Parse(time.RFC3339, tx.Metadata.GetString("end_time"))
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := w.db.Rewards.OwnerAddresses(tx.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	period := &model.StakingPeriod{
+		ID:          tx.ID,
+		Chain:       block.Chain,
+		Type:        model.StakingTypeDelegator,
+		NodeID:      tx.Metadata.GetString("node_id"),
+		StakeAmount: uint64(tx.Metadata.GetFloat64("weight")),
+		StartTime:   startTime,
+		EndTime:     endTime,
+		AddedHeight: block.Height,
+		AddedAt:     block.Timestamp,
+	}
+
+	if tx.Type == model.TxTypeAddValidator {
+		fee := tx.Metadata.GetFloat64("commission_rate")
+		period.Type = model.StakingTypeValidator
+		period.DelegationFee = &fee
+	}
+	if len(addresses) > 0 {
+		period.RewardAddress = addresses[0]
+	}
+
+	return period, nil
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/figment-networks/avalanche-indexer/store"
 )
 
+const (
+	secondsPerYear = 365 * 24 * 60 * 60
+)
+
 type Worker struct {
 	log           *logrus.Logger
 	rpc           *client.Client
@@ -157,6 +161,10 @@ func (w *Worker) getSyncStatus() (*model.SyncStatus, error) {
 }
 
 func (w Worker) processBlockTx(block *model.Block, tx *model.Transaction) error {
+	if err := w.updateStakingPeriod(block, tx); err != nil {
+		return err
+	}
+
 	switch tx.Type {
 	case model.TxTypeAddValidator:
 		return w.createAddValidatorEvent(block, tx)
@@ -288,6 +296,51 @@ func (w Worker) createFinishValidatorEvent(block *model.Block, tx *model.Transac
 		return fmt.Errorf("unhandled reward validator tx type: %s", refTx.Type)
 	}
 
+	if err := w.createEvent(event); err != nil {
+		return err
+	}
+
+	return w.createRewardEvent(block, tx, refTx)
+}
+
+// createRewardEvent records the reward paid out, or forfeited, at the end of the staking period
+func (w Worker) createRewardEvent(block *model.Block, tx *model.Transaction, refTx *model.Transaction) error {
+	reward, err := w.stakingReward(refTx.ID)
+	if err != nil {
+		return err
+	}
+
+	stakerType := model.EventItemTypeValidator
+	if refTx.Type == model.TxTypeAddDelegator {
+		stakerType = model.EventItemTypeDelegator
+	}
+
+	event := w.initEvent(block, tx)
+	event.Scope = model.EventScopeRewards
+	event.ItemID = refTx.Metadata.GetString("node_id")
+	event.ItemType = model.EventItemTypeValidator
+
+	switch {
+	case !reward.rewarded:
+		event.Type = model.EventTypeRewardForfeited
+	case stakerType == model.EventItemTypeValidator:
+		event.Type = model.EventTypeValidatorRewarded
+	default:
+		event.Type = model.EventTypeDelegatorRewarded
+	}
+
+	weight := uint64(refTx.Metadata.GetFloat64("weight"))
+	duration := refTx.Metadata.GetInt("duration")
+
+	event.Data = types.NewMap()
+	event.Data["staking_tx_id"] = refTx.ID
+	event.Data["staker_type"] = stakerType
+	event.Data["amount"] = reward.amount
+	event.Data["reward_addresses"] = reward.addresses
+	event.Data["weight"] = weight
+	event.Data["duration"] = duration
+	event.Data["apr"] = rewardAPR(reward.amount, weight, duration)
+
 	return w.createEvent(event)
 }
 
@@ -301,6 +354,35 @@ func (w Worker) createAddSubnetValidatorEvent(block *model.Block, tx *model.Tran
 	return w.createEvent(event)
 }
 
+// ownerRewardAmount returns the total of reward outputs paid to the rewards owner addresses.
+// Delegator reward UTXOs also include the validator fee paid to the validator owner.
+func ownerRewardAmount(outputs []model.Output, addresses []string) uint64 {
+	owners := map[string]bool{}
+	for _, addr := range addresses {
+		owners[addr] = true
+	}
+
+	var amount uint64
+	for _, output := range outputs {
+		for _, addr := range output.Addresses {
+			if owners[addr] {
+				amount += output.Amount
+				break
+			}
+		}
+	}
+
+	return amount
+}
+
+// rewardAPR returns the annualized reward rate of the stake weight
+func rewardAPR(amount uint64, weight uint64, duration int) float64 {
+	if weight == 0 || duration <= 0 {
+		return 0
+	}
+	return float64(amount) / float64(weight) * secondsPerYear / float64(duration)
+}
+
 func (w Worker) createEvent(event *model.Event) error {
 	w.log.
 		WithField("chain", w.syncStatusKey).
@@ -0,0 +1,63 @@
+package blocks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+)
+
+func TestOwnerRewardAmount(t *testing.T) {
+	outputs := []model.Output{
+		{Amount: 100, Addresses: []string{"owner"}},
+		{Amount: 20, Addresses: []string{"validator"}},
+		{Amount: 3, Addresses: []string{"validator", "owner"}},
+		{Amount: 4, Addresses: []string{"other", "owner2"}},
+	}
+
+	examples := []struct {
+		name      string
+		outputs   []model.Output
+		addresses []string
+		amount    uint64
+	}{
+		{"no outputs", nil, []string{"owner"}, 0},
+		{"no owners", outputs, nil, 0},
+		{"single owner", outputs, []string{"owner"}, 103},
+		{"validator fee", outputs, []string{"validator"}, 23},
+		{"multiple owners", outputs, []string{"owner", "owner2"}, 107},
+		{"multisig output counted once", outputs, []string{"owner", "validator"}, 123},
+		{"unknown owner", outputs, []string{"unknown"}, 0},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			assert.Equal(t, ex.amount, ownerRewardAmount(ex.outputs, ex.addresses))
+		})
+	}
+}
+
+func TestRewardAPR(t *testing.T) {
+	examples := []struct {
+		name     string
+		amount   uint64
+		weight   uint64
+		duration int
+		apr      float64
+	}{
+		{"zero weight", 100, 0, secondsPerYear, 0},
+		{"zero duration", 100, 1000, 0, 0},
+		{"negative duration", 100, 1000, -1, 0},
+		{"zero amount", 0, 1000, secondsPerYear, 0},
+		{"full year", 100, 1000, secondsPerYear, 0.1},
+		{"half year", 50, 1000, secondsPerYear / 2, 0.1},
+		{"two weeks", 10, 2000, 14 * 24 * 60 * 60, 0.13035714285714287},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			assert.InDelta(t, ex.apr, rewardAPR(ex.amount, ex.weight, ex.duration), 1e-12)
+		})
+	}
+}
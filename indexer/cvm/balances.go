@@ -0,0 +1,53 @@
+package cvm
+
+import (
+	"math/big"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+	"github.com/figment-networks/avalanche-indexer/util"
+)
+
+// prepareAtomicBalanceChanges returns native balance changes of the imported and exported AVAX.
+// Atomic transactions are applied after the block transactions, so their index follows them.
+func (w Worker) prepareAtomicBalanceChanges(tx *model.Transaction, txIndex int) []model.EvmBalanceChange {
+	var (
+		changeType string
+		entries    []model.Output
+		sign       int64
+	)
+
+	switch tx.Type {
+	case model.TxTypeAtomicImport:
+		changeType, entries, sign = model.BalanceChangeImport, tx.Outputs, 1
+	case model.TxTypeAtomicExport:
+		changeType, entries, sign = model.BalanceChangeExport, tx.Inputs, -1
+	default:
+		return nil
+	}
+
+	result := []model.EvmBalanceChange{}
+
+	for _, entry := range entries {
+		if entry.Asset != w.avaxAsset || len(entry.Addresses) == 0 {
+			continue
+		}
+
+		amount := new(big.Int).SetUint64(entry.Amount)
+		amount.Mul(amount, big.NewInt(util.WeiPerNavax))
+		amount.Mul(amount, big.NewInt(sign))
+
+		result = append(result, model.EvmBalanceChange{
+			TxHash:      tx.ID,
+			ChangeIndex: len(result),
+			BlockHeight: *tx.BlockHeight,
+			TxIndex:     txIndex,
+			Address:     entry.Addresses[0],
+			Type:        changeType,
+			Amount:      types.Amount{Int: amount},
+			Timestamp:   tx.Timestamp,
+		})
+	}
+
+	return result
+}
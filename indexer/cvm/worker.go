@@ -10,6 +10,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
 	"github.com/ava-labs/coreth/plugin/evm"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/sirupsen/logrus"
 
@@ -24,42 +26,47 @@ import (
 )
 
 const (
-	containerType  = "C"
+	ContainerType  = "C"
 	fetchBatchSize = 256
 )
 
 type Worker struct {
-	chain       string
-	avaxAsset   string
-	store       *store.DB
-	codec       codec.Manager
-	indexClient *client.IndexClient
-	evmClient   *client.EvmClient
-	status      *model.SyncStatus
-	log         *logrus.Logger
-	ethChainID  *big.Int
-	ethSigner   corethTypes.Signer
+	chain      string
+	avaxAsset  string
+	store      *store.DB
+	codec      codec.Manager
+	source     shared.ContainerSource
+	evmClient  *client.EvmClient
+	status     *model.SyncStatus
+	log        *logrus.Logger
+	ethChainID *big.Int
+	ethSigner  corethTypes.Signer
+
+	failurePolicy shared.FailurePolicy
 }
 
 func NewWorker(
 	store *store.DB,
 	codec codec.Manager,
-	indexClient *client.IndexClient,
+	source shared.ContainerSource,
 	evmClient *client.EvmClient,
 	chain string,
 	avaxAsset string,
 	ethChainID *big.Int,
+	failurePolicy shared.FailurePolicy,
 ) Worker {
 	return Worker{
-		chain:       chain,
-		avaxAsset:   avaxAsset,
-		store:       store,
-		codec:       codec,
-		indexClient: indexClient,
-		evmClient:   evmClient,
-		log:         logrus.StandardLogger(),
-		ethChainID:  ethChainID,
-		ethSigner:   corethTypes.LatestSignerForChainID(ethChainID),
+		chain:      chain,
+		avaxAsset:  avaxAsset,
+		store:      store,
+		codec:      codec,
+		source:     source,
+		evmClient:  evmClient,
+		log:        logrus.StandardLogger(),
+		ethChainID: ethChainID,
+		ethSigner:  corethTypes.LatestSignerForChainID(ethChainID),
+
+		failurePolicy: failurePolicy,
 	}
 }
 
@@ -100,47 +107,43 @@ func (w *Worker) Start(ctx context.Context) {
 }
 
 func (w *Worker) Run() error {
-	status, err := shared.GetSyncStatus(w.indexClient, w.store, containerType, w.chain)
+	status, err := shared.GetSyncStatus(w.source, w.store)
 	if err != nil {
 		return err
 	}
 	w.status = status
 
+	if err := shared.RetryFailedContainers(w.status, w.source, w.store, w.failurePolicy, w.processMessage); err != nil {
+		return err
+	}
+
 	if w.status.AtTip() {
 		return nil
 	}
 
 	return shared.ProcessContainerRange(
 		w.status,
-		w.indexClient,
+		w.source,
 		w.store,
-		containerType,
 		fetchBatchSize,
-		w.ProcessMessage,
+		w.failurePolicy,
+		w.processMessage,
 	)
 }
 
+// ProcessMessage processes a single container within a database transaction
 func (w Worker) ProcessMessage(message *model.RawMessage) error {
-	var (
-		raw []byte
-		err error
-	)
+	return w.store.Transaction(func(db *store.DB) error {
+		return w.processMessage(db, message)
+	})
+}
 
-	raw, err = formatting.Decode(formatting.Hex, message.Data)
+func (w Worker) processMessage(db *store.DB, message *model.RawMessage) error {
+	block, err := DecodeBlock(message.Data)
 	if err != nil {
 		return err
 	}
 
-	proposerBlock, err := block.Parse(raw)
-	if err == nil {
-		raw = proposerBlock.Block()
-	}
-
-	block := &corethTypes.Block{}
-	if err := rlp.DecodeBytes(raw, block); err != nil {
-		return err
-	}
-
 	ourBlock, err := w.prepareBlock(block)
 	if err != nil {
 		return err
@@ -150,28 +153,60 @@ func (w Worker) ProcessMessage(message *model.RawMessage) error {
 	if err != nil {
 		return err
 	}
-	for _, tx := range atomicTxs {
-		if err := w.saveAtomicTx(&tx); err != nil {
+	balanceChanges := []model.EvmBalanceChange{}
+	for idx, tx := range atomicTxs {
+		if err := w.saveAtomicTx(db, &tx); err != nil {
 			return err
 		}
+		balanceChanges = append(balanceChanges, w.prepareAtomicBalanceChanges(&tx, len(block.Transactions())+idx)...)
+	}
+	if err := db.Evm.ImportBalanceChanges(balanceChanges); err != nil {
+		return err
 	}
 
-	if err := w.store.Platform.CreateBlock(ourBlock); err != nil {
+	if err := db.Platform.CreateBlock(ourBlock); err != nil {
 		return err
 	}
 
-	for _, ethTx := range block.Transactions() {
-		tx, err := w.prepareEvmTx(block, ethTx)
+	if err := db.Evm.CreateBlock(w.prepareEvmBlock(block)); err != nil {
+		return err
+	}
+
+	evmTxs := make([]model.EvmTransaction, len(block.Transactions()))
+
+	for idx, ethTx := range block.Transactions() {
+		tx, evmTx, err := w.prepareEvmTx(block, ethTx, idx)
 		if err != nil {
 			return err
 		}
 
-		if err := w.store.Platform.CreateTransaction(tx); err != nil {
+		if err := db.Platform.CreateTransaction(tx); err != nil {
 			return err
 		}
+		evmTxs[idx] = *evmTx
 	}
 
-	return nil
+	return db.Evm.ImportTransactions(evmTxs)
+}
+
+// DecodeBlock decodes the hex encoded C-chain container into an ethereum block
+func DecodeBlock(data string) (*corethTypes.Block, error) {
+	raw, err := formatting.Decode(formatting.Hex, data)
+	if err != nil {
+		return nil, err
+	}
+
+	proposerBlock, err := block.Parse(raw)
+	if err == nil {
+		raw = proposerBlock.Block()
+	}
+
+	ethBlock := &corethTypes.Block{}
+	if err := rlp.DecodeBytes(raw, ethBlock); err != nil {
+		return nil, err
+	}
+
+	return ethBlock, nil
 }
 
 func (w Worker) prepareBlock(ethBlock *corethTypes.Block) (*model.Block, error) {
@@ -185,6 +220,34 @@ func (w Worker) prepareBlock(ethBlock *corethTypes.Block) (*model.Block, error)
 	}, nil
 }
 
+// prepareEvmBlock returns the full header details of the C-chain block
+func (w Worker) prepareEvmBlock(block *corethTypes.Block) *model.EvmBlock {
+	header := block.Header()
+
+	return &model.EvmBlock{
+		Hash:           block.Hash().String(),
+		Chain:          w.chain,
+		Height:         block.NumberU64(),
+		ParentHash:     header.ParentHash.String(),
+		Coinbase:       header.Coinbase.String(),
+		StateRoot:      header.Root.String(),
+		TxRoot:         header.TxHash.String(),
+		ReceiptsRoot:   header.ReceiptHash.String(),
+		ExtDataHash:    header.ExtDataHash.String(),
+		Difficulty:     types.Amount{Int: header.Difficulty},
+		GasLimit:       header.GasLimit,
+		GasUsed:        header.GasUsed,
+		BaseFee:        types.Amount{Int: header.BaseFee},
+		ExtDataGasUsed: types.Amount{Int: header.ExtDataGasUsed},
+		BlockGasCost:   types.Amount{Int: header.BlockGasCost},
+		ExtraData:      hexutil.Encode(header.Extra),
+		ExtDataSize:    len(block.ExtData()),
+		TxCount:        len(block.Transactions()),
+		Size:           uint64(block.Size()),
+		Timestamp:      time.Unix(int64(block.Time()), 0),
+	}
+}
+
 func (w Worker) prepareTxs(block *corethTypes.Block) ([]model.Transaction, error) {
 	blockHash := block.Hash().String()
 	blockHeight := block.Number().Uint64()
@@ -246,12 +309,12 @@ func (w Worker) prepareTxs(block *corethTypes.Block) ([]model.Transaction, error
 	return resultTxs, nil
 }
 
-func (w Worker) saveAtomicTx(tx *model.Transaction) error {
-	if err := w.store.Platform.CreateTransaction(tx); err != nil {
+func (w Worker) saveAtomicTx(db *store.DB, tx *model.Transaction) error {
+	if err := db.Platform.CreateTransaction(tx); err != nil {
 		return err
 	}
 
-	if err := w.store.Platform.CreateTxOutputs(tx.Outputs); err != nil {
+	if err := db.Platform.CreateTxOutputs(tx.Outputs); err != nil {
 		return err
 	}
 
@@ -260,11 +323,11 @@ func (w Worker) saveAtomicTx(tx *model.Transaction) error {
 		spentIDs[idx] = input.ID
 	}
 
-	if err := w.store.Platform.CreateTxInputs(spentIDs, tx.ID); err != nil {
+	if err := db.Platform.CreateTxInputs(spentIDs, tx.ID); err != nil {
 		return err
 	}
 
-	if err := w.store.Platform.MarkOutputsSpent(spentIDs, tx.ID, tx.Timestamp); err != nil {
+	if err := db.Platform.MarkOutputsSpent(spentIDs, tx.ID, tx.Timestamp); err != nil {
 		return err
 	}
 
@@ -333,10 +396,10 @@ func (w Worker) prepareAtomicExportTx(tx *evm.UnsignedExportTx) (*model.Transact
 	return transaction, nil
 }
 
-func (w Worker) prepareEvmTx(block *corethTypes.Block, ethTx *corethTypes.Transaction) (*model.Transaction, error) {
+func (w Worker) prepareEvmTx(block *corethTypes.Block, ethTx *corethTypes.Transaction, idx int) (*model.Transaction, *model.EvmTransaction, error) {
 	msg, err := ethTx.AsMessage(w.ethSigner, block.Header().BaseFee)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	nonce := msg.Nonce()
 
@@ -348,6 +411,21 @@ func (w Worker) prepareEvmTx(block *corethTypes.Block, ethTx *corethTypes.Transa
 		"gas":       ethTx.Gas(),
 		"gas_price": ethTx.GasPrice().String(),
 		"cost":      ethTx.Cost().String(),
+		"tx_type":   ethTx.Type(),
+
+		// Gas price actually paid, capped by the fee cap for dynamic fee transactions
+		"effective_gas_price": msg.GasPrice().String(),
+	}
+
+	if baseFee := block.BaseFee(); baseFee != nil {
+		meta["base_fee"] = baseFee.String()
+	}
+	if ethTx.Type() == corethTypes.DynamicFeeTxType {
+		meta["max_fee_per_gas"] = ethTx.GasFeeCap().String()
+		meta["max_priority_fee_per_gas"] = ethTx.GasTipCap().String()
+	}
+	if accessList := ethTx.AccessList(); len(accessList) > 0 {
+		meta["access_list"] = accessList
 	}
 
 	tx := &model.Transaction{
@@ -362,5 +440,37 @@ func (w Worker) prepareEvmTx(block *corethTypes.Block, ethTx *corethTypes.Transa
 		Metadata:    meta,
 	}
 
-	return tx, nil
+	v, r, sig := ethTx.RawSignatureValues()
+
+	evmTx := &model.EvmTransaction{
+		Hash:        tx.ID,
+		BlockHash:   *tx.Block,
+		BlockHeight: block.NumberU64(),
+		TxIndex:     idx,
+		Type:        int(ethTx.Type()),
+		From:        msg.From().String(),
+		Nonce:       nonce,
+		Value:       types.Amount{Int: ethTx.Value()},
+		Gas:         ethTx.Gas(),
+		GasPrice:    types.Amount{Int: msg.GasPrice()},
+		Input:       hexutil.Encode(ethTx.Data()),
+		V:           hexutil.EncodeBig(v),
+		R:           hexutil.EncodeBig(r),
+		S:           hexutil.EncodeBig(sig),
+		Timestamp:   tx.Timestamp,
+	}
+
+	if to := ethTx.To(); to != nil {
+		evmTx.To = util.StringPtr(to.String())
+	} else {
+		evmTx.ContractCreation = true
+		evmTx.ContractAddress = util.StringPtr(crypto.CreateAddress(msg.From(), nonce).String())
+	}
+
+	if ethTx.Type() == corethTypes.DynamicFeeTxType {
+		evmTx.MaxFeePerGas = types.Amount{Int: ethTx.GasFeeCap()}
+		evmTx.MaxPriorityFeePerGas = types.Amount{Int: ethTx.GasTipCap()}
+	}
+
+	return tx, evmTx, nil
 }
@@ -0,0 +1,61 @@
+package evm
+
+import (
+	"math/big"
+	"time"
+
+	corethTypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+var (
+	// Approval(address,address,uint256) event signature
+	approvalTopic = common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+)
+
+// createTokenApprovals stores ERC-20 approvals found in the receipt logs
+// and updates the allowances of the approved spenders
+func (w *Worker) createTokenApprovals(logs []*corethTypes.Log, timestamp time.Time) error {
+	approvals := []model.TokenApproval{}
+	keys := []store.AllowanceKey{}
+	seen := map[store.AllowanceKey]bool{}
+
+	for _, logEntry := range logs {
+		// ERC-721 approvals share the signature but index the token ID as the 4th topic
+		if len(logEntry.Topics) != 3 || logEntry.Topics[0] != approvalTopic || len(logEntry.Data) != 32 {
+			continue
+		}
+
+		approval := model.TokenApproval{
+			TxHash:      logEntry.TxHash.String(),
+			BlockHeight: logEntry.BlockNumber,
+			LogIndex:    int(logEntry.Index),
+			Token:       logEntry.Address.String(),
+			Owner:       topicAddress(logEntry.Topics[1]),
+			Spender:     topicAddress(logEntry.Topics[2]),
+			Amount:      types.Amount{Int: new(big.Int).SetBytes(logEntry.Data)},
+			Timestamp:   timestamp,
+		}
+		approvals = append(approvals, approval)
+
+		key := store.AllowanceKey{Token: approval.Token, Owner: approval.Owner, Spender: approval.Spender}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	if len(approvals) == 0 {
+		return nil
+	}
+
+	if err := w.db.Tokens.ImportApprovals(approvals); err != nil {
+		return err
+	}
+
+	return w.db.Tokens.RefreshAllowances(keys)
+}
@@ -0,0 +1,67 @@
+package evm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+// createBalanceChanges stores native balance changes caused by the transaction
+func (w *Worker) createBalanceChanges(tx *model.Transaction, data *fetchData) error {
+	return w.db.Evm.ImportBalanceChanges(prepareBalanceChanges(tx, data))
+}
+
+// prepareBalanceChanges returns the fee paid by the sender and values moved by
+// the top level and internal calls of the transaction trace
+func prepareBalanceChanges(tx *model.Transaction, data *fetchData) []model.EvmBalanceChange {
+	if data.trace == nil {
+		return nil
+	}
+
+	changes := []model.EvmBalanceChange{}
+
+	add := func(address common.Address, changeType string, amount *big.Int, counterparty *common.Address) {
+		change := model.EvmBalanceChange{
+			TxHash:      data.receipt.TxHash.String(),
+			ChangeIndex: len(changes),
+			BlockHeight: data.receipt.BlockNumber.Uint64(),
+			TxIndex:     int(data.receipt.TransactionIndex),
+			Address:     address.String(),
+			Type:        changeType,
+			Amount:      types.Amount{Int: amount},
+			Timestamp:   tx.Timestamp,
+		}
+		if counterparty != nil {
+			addr := counterparty.String()
+			change.Counterparty = &addr
+		}
+		changes = append(changes, change)
+	}
+
+	// Fee is charged even when the transaction is reverted
+	if fee := txFee(tx, data.receipt); fee.Sign() > 0 {
+		add(data.trace.From, model.BalanceChangeFee, new(big.Int).Neg(fee), nil)
+	}
+
+	for _, call := range client.FlattenTraces(data.trace) {
+		if call.Revert || call.Value == nil || call.Value.Sign() <= 0 {
+			continue
+		}
+
+		// Delegate and static calls do not move value, callcode sends it back to the caller
+		switch call.Type {
+		case "DELEGATECALL", "STATICCALL", "CALLCODE":
+			continue
+		}
+
+		from, to := call.From, call.To
+		add(from, model.BalanceChangeTransfer, new(big.Int).Neg(call.Value), &to)
+		add(to, model.BalanceChangeTransfer, new(big.Int).Set(call.Value), &from)
+	}
+
+	return changes
+}
@@ -0,0 +1,126 @@
+package evm
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/model"
+)
+
+var (
+	// Upgraded(address) event signature emitted by EIP-1967 proxies
+	upgradedTopic = common.HexToHash("0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b")
+)
+
+// createContracts stores the contracts deployed by the transaction and
+// records proxy implementation changes
+func (w *Worker) createContracts(data *fetchData, timestamp time.Time) error {
+	txHash := data.receipt.TxHash.String()
+	height := data.receipt.BlockNumber.Uint64()
+
+	for _, contract := range createdContracts(data) {
+		record := &model.Contract{
+			Address:     contract.Address.String(),
+			Creator:     contract.Creator.String(),
+			CreationTx:  txHash,
+			BlockHeight: height,
+			CreatedAt:   timestamp,
+		}
+
+		code, err := w.rpc.Evm.ContractCode(context.Background(), contract.Address, data.receipt.BlockNumber)
+		if err != nil {
+			return err
+		}
+		if len(code) > 0 {
+			hash := crypto.Keccak256Hash(code).String()
+			record.BytecodeHash = &hash
+			record.BytecodeSize = len(code)
+		}
+
+		if err := w.db.Contracts.Create(record); err != nil {
+			return err
+		}
+
+		proxy, err := w.rpc.Evm.ProxyImplementation(context.Background(), contract.Address, data.receipt.BlockNumber)
+		if err != nil {
+			return err
+		}
+		if proxy == nil {
+			continue
+		}
+
+		err = w.db.Contracts.AddImplementation(&model.ContractImplementation{
+			Contract:       record.Address,
+			Implementation: proxy.Address.String(),
+			ProxyType:      proxy.Type,
+			BlockHeight:    height,
+			TxHash:         txHash,
+			Timestamp:      timestamp,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, logEntry := range data.receipt.Logs {
+		if len(logEntry.Topics) != 2 || logEntry.Topics[0] != upgradedTopic {
+			continue
+		}
+
+		err := w.db.Contracts.AddImplementation(&model.ContractImplementation{
+			Contract:       logEntry.Address.String(),
+			Implementation: common.BytesToAddress(logEntry.Topics[1].Bytes()).String(),
+			ProxyType:      model.ProxyTypeEIP1967,
+			BlockHeight:    height,
+			TxHash:         txHash,
+			Timestamp:      timestamp,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type createdContract struct {
+	Address common.Address
+	Creator common.Address
+}
+
+// createdContracts returns the contracts deployed by the transaction itself
+// and by the CREATE and CREATE2 frames of its trace
+func createdContracts(data *fetchData) []createdContract {
+	result := []createdContract{}
+	seen := map[common.Address]bool{}
+
+	if data.trace != nil {
+		for _, call := range client.FlattenTraces(data.trace) {
+			if call.Revert || (call.Type != "CREATE" && call.Type != "CREATE2") {
+				continue
+			}
+			if call.To == (common.Address{}) || seen[call.To] {
+				continue
+			}
+
+			seen[call.To] = true
+			result = append(result, createdContract{Address: call.To, Creator: call.From})
+		}
+	}
+
+	// Fall back to the receipt when the trace is missing the deployment
+	address := data.receipt.ContractAddress
+	if address != (common.Address{}) && !seen[address] && data.receipt.Status == 1 {
+		creator := common.Address{}
+		if data.trace != nil {
+			creator = data.trace.From
+		}
+		result = append(result, createdContract{Address: address, Creator: creator})
+	}
+
+	return result
+}
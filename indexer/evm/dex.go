@@ -0,0 +1,189 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	corethTypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+var (
+	// Uniswap-V2 pair event signatures
+	swapTopic = common.HexToHash("0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822")
+	syncTopic = common.HexToHash("0x1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1")
+	mintTopic = common.HexToHash("0x4c209b5fc8ad50758f13e2e1088ba56a560dff690a1c6fef26394f4c03821c4f")
+	burnTopic = common.HexToHash("0xdccd412f0b1252819cb1fd330b93224ca42612892bb3f4f789976e6d81936496")
+)
+
+// pairReserves holds the reserves of the latest Sync event of the pair
+type pairReserves struct {
+	reserve0 types.Amount
+	reserve1 types.Amount
+}
+
+// createDexEvents stores swaps and liquidity changes of Uniswap-V2-style pairs.
+// Pairs emit Sync before Swap, Mint and Burn, so the reserves after the event
+// are taken from the preceding Sync of the same pair.
+func (w *Worker) createDexEvents(logs []*corethTypes.Log, origin string, timestamp time.Time) error {
+	swaps := []model.DexSwap{}
+	events := []model.DexLiquidityEvent{}
+	reserves := map[common.Address]pairReserves{}
+
+	for _, logEntry := range logs {
+		if len(logEntry.Topics) == 0 {
+			continue
+		}
+
+		topic := logEntry.Topics[0]
+		if topic != swapTopic && topic != syncTopic && topic != mintTopic && topic != burnTopic {
+			continue
+		}
+		if !validPairLog(logEntry) {
+			continue
+		}
+
+		if topic == syncTopic {
+			reserves[logEntry.Address] = pairReserves{
+				reserve0: wordAmount(logEntry.Data, 0),
+				reserve1: wordAmount(logEntry.Data, 1),
+			}
+			continue
+		}
+
+		pair, err := w.ensurePair(logEntry.Address.String(), timestamp)
+		if err != nil {
+			return err
+		}
+		if pair == nil {
+			continue
+		}
+
+		state := reserves[logEntry.Address]
+
+		switch topic {
+		case swapTopic:
+			swaps = append(swaps, model.DexSwap{
+				TxHash:      logEntry.TxHash.String(),
+				LogIndex:    int(logEntry.Index),
+				BlockHeight: logEntry.BlockNumber,
+				Pair:        pair.Address,
+				Token0:      pair.Token0,
+				Token1:      pair.Token1,
+				Origin:      origin,
+				Sender:      topicAddress(logEntry.Topics[1]),
+				Recipient:   topicAddress(logEntry.Topics[2]),
+				Amount0In:   wordAmount(logEntry.Data, 0),
+				Amount1In:   wordAmount(logEntry.Data, 1),
+				Amount0Out:  wordAmount(logEntry.Data, 2),
+				Amount1Out:  wordAmount(logEntry.Data, 3),
+				Reserve0:    state.reserve0,
+				Reserve1:    state.reserve1,
+				Timestamp:   timestamp,
+			})
+		case mintTopic, burnTopic:
+			event := model.DexLiquidityEvent{
+				TxHash:      logEntry.TxHash.String(),
+				LogIndex:    int(logEntry.Index),
+				BlockHeight: logEntry.BlockNumber,
+				Type:        model.DexLiquidityMint,
+				Pair:        pair.Address,
+				Token0:      pair.Token0,
+				Token1:      pair.Token1,
+				Origin:      origin,
+				Sender:      topicAddress(logEntry.Topics[1]),
+				Amount0:     wordAmount(logEntry.Data, 0),
+				Amount1:     wordAmount(logEntry.Data, 1),
+				Reserve0:    state.reserve0,
+				Reserve1:    state.reserve1,
+				Timestamp:   timestamp,
+			}
+			if topic == burnTopic {
+				recipient := topicAddress(logEntry.Topics[2])
+				event.Type = model.DexLiquidityBurn
+				event.Recipient = &recipient
+			}
+			events = append(events, event)
+		}
+	}
+
+	if err := w.db.Dex.ImportSwaps(swaps); err != nil {
+		return err
+	}
+	return w.db.Dex.ImportLiquidityEvents(events)
+}
+
+// validPairLog checks the number of indexed topics and the data size of the pair event
+func validPairLog(logEntry *corethTypes.Log) bool {
+	topics, data := len(logEntry.Topics), len(logEntry.Data)
+
+	switch logEntry.Topics[0] {
+	case swapTopic:
+		return topics == 3 && data == 128
+	case syncTopic:
+		return topics == 1 && data == 64
+	case mintTopic:
+		return topics == 2 && data == 64
+	case burnTopic:
+		return topics == 3 && data == 64
+	}
+	return false
+}
+
+// ensurePair returns the pair record, creating it on first sight.
+// Contracts that do not implement the pair interface return nil.
+func (w *Worker) ensurePair(address string, timestamp time.Time) (*model.DexPair, error) {
+	if pair, ok := w.knownPairs[address]; ok {
+		return pair, nil
+	}
+
+	pair, err := w.db.Dex.GetPair(address)
+	if err == nil {
+		w.knownPairs[address] = pair
+		return pair, nil
+	}
+	if err != store.ErrNotFound {
+		return nil, err
+	}
+
+	info, err := w.rpc.Evm.PairInfo(context.Background(), address)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		w.knownPairs[address] = nil
+		return nil, nil
+	}
+
+	pair = &model.DexPair{
+		Address:   address,
+		Token0:    info.Token0.String(),
+		Token1:    info.Token1.String(),
+		CreatedAt: timestamp,
+	}
+	if info.Factory != nil {
+		factory := info.Factory.String()
+		pair.Factory = &factory
+	}
+
+	if err := w.db.Dex.CreatePair(pair); err != nil {
+		return nil, err
+	}
+
+	w.knownPairs[address] = pair
+	return pair, nil
+}
+
+func topicAddress(topic common.Hash) string {
+	return common.BytesToAddress(topic.Bytes()).String()
+}
+
+// wordAmount returns the 32-byte word at the given position of the log data
+func wordAmount(data []byte, idx int) types.Amount {
+	return types.Amount{Int: new(big.Int).SetBytes(data[idx*32 : (idx+1)*32])}
+}
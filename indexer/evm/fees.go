@@ -0,0 +1,60 @@
+package evm
+
+import (
+	"math/big"
+
+	corethTypes "github.com/ava-labs/coreth/core/types"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+	"github.com/figment-networks/avalanche-indexer/util"
+)
+
+// updateTxFee stores the fee paid by the transaction in nAVAX, same as X and P chain
+// transactions. Amounts in wei are merged into the transaction metadata.
+func (w *Worker) updateTxFee(tx *model.Transaction, receipt *corethTypes.Receipt) error {
+	gasUsed := new(big.Int).SetUint64(receipt.GasUsed)
+	fee := txFee(tx, receipt)
+
+	meta := types.Map{
+		"gas_used": receipt.GasUsed,
+		"fee":      fee.String(),
+	}
+
+	// Base fee part is burned, the rest of the effective price is the priority tip
+	if baseFee, ok := metaBigInt(tx.Metadata, "base_fee"); ok {
+		burned := new(big.Int).Mul(gasUsed, baseFee)
+		if burned.Cmp(fee) > 0 {
+			burned.Set(fee)
+		}
+
+		meta["burned_fee"] = burned.String()
+		meta["priority_fee"] = new(big.Int).Sub(fee, burned).String()
+	}
+
+	navax := new(big.Int).Quo(fee, big.NewInt(util.WeiPerNavax))
+	if !navax.IsUint64() {
+		navax.SetUint64(0)
+	}
+
+	return w.db.Transactions.UpdateFee(tx.ID, navax.Uint64(), meta)
+}
+
+// txFee returns the fee paid by the transaction in wei
+func txFee(tx *model.Transaction, receipt *corethTypes.Receipt) *big.Int {
+	// Transactions indexed before the effective price was recorded fall back to the gas price
+	gasPrice, ok := metaBigInt(tx.Metadata, "effective_gas_price")
+	if !ok {
+		gasPrice, _ = metaBigInt(tx.Metadata, "gas_price")
+	}
+
+	return new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), gasPrice)
+}
+
+func metaBigInt(meta types.Map, key string) (*big.Int, bool) {
+	val, ok := new(big.Int).SetString(meta.GetString(key), 10)
+	if !ok {
+		return new(big.Int), false
+	}
+	return val, true
+}
@@ -0,0 +1,163 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	corethTypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/model"
+)
+
+const (
+	// TraceModeTransaction traces every transaction with a separate request
+	TraceModeTransaction = "transaction"
+
+	// TraceModeBlock traces all transactions of a block with a single request
+	TraceModeBlock = "block"
+)
+
+// TraceConfig controls how the worker fetches transaction traces
+type TraceConfig struct {
+	Mode          string
+	Concurrency   int
+	SkipTransfers bool
+}
+
+func (c TraceConfig) Validate() error {
+	switch c.Mode {
+	case TraceModeTransaction, TraceModeBlock:
+	default:
+		return fmt.Errorf("invalid trace mode: %q", c.Mode)
+	}
+	if c.Concurrency < 1 {
+		return fmt.Errorf("invalid trace concurrency: %d", c.Concurrency)
+	}
+	return nil
+}
+
+// evmClient is the part of the EVM RPC client used to fetch receipts and traces
+type evmClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*corethTypes.Receipt, error)
+	ContractCode(ctx context.Context, address common.Address, block *big.Int) ([]byte, error)
+	TraceTransaction(ctx context.Context, hash string) (*client.Call, error)
+	TraceBlockByNumber(ctx context.Context, number *big.Int) ([]*client.Call, error)
+}
+
+// fetchTxsData fetches receipts and traces of the transactions in parallel.
+// Receipts are fetched first since the trace policy depends on them.
+func (w *Worker) fetchTxsData(txs []model.Transaction) []fetchData {
+	results := make([]fetchData, len(txs))
+
+	doConcurrently(len(txs), w.traceConfig.Concurrency, func(idx int) {
+		data := &results[idx]
+		data.txID = txs[idx].ID
+
+		data.receipt, data.err = w.evm.TransactionReceipt(context.Background(), common.HexToHash(data.txID))
+		if data.err != nil || !w.traceConfig.SkipTransfers {
+			return
+		}
+
+		data.trace, data.err = w.transferTrace(&txs[idx], data)
+	})
+
+	pending := []int{}
+	for idx := range results {
+		if results[idx].err == nil && results[idx].trace == nil {
+			pending = append(pending, idx)
+		}
+	}
+
+	if w.traceConfig.Mode == TraceModeBlock {
+		w.traceBlocks(results, pending)
+		return results
+	}
+
+	doConcurrently(len(pending), w.traceConfig.Concurrency, func(i int) {
+		data := &results[pending[i]]
+		data.trace, data.err = w.evm.TraceTransaction(context.Background(), data.txID)
+	})
+
+	return results
+}
+
+// traceBlocks fetches traces of the pending transactions with one request per block
+func (w *Worker) traceBlocks(results []fetchData, pending []int) {
+	heights := []*big.Int{}
+	txsByHeight := map[uint64][]int{}
+
+	for _, idx := range pending {
+		number := results[idx].receipt.BlockNumber
+		if _, ok := txsByHeight[number.Uint64()]; !ok {
+			heights = append(heights, number)
+		}
+		txsByHeight[number.Uint64()] = append(txsByHeight[number.Uint64()], idx)
+	}
+
+	doConcurrently(len(heights), w.traceConfig.Concurrency, func(i int) {
+		traces, err := w.evm.TraceBlockByNumber(context.Background(), heights[i])
+
+		for _, idx := range txsByHeight[heights[i].Uint64()] {
+			data := &results[idx]
+			if err != nil {
+				data.err = err
+				continue
+			}
+
+			txIndex := int(data.receipt.TransactionIndex)
+			if txIndex >= len(traces) {
+				data.err = fmt.Errorf("trace of transaction %d not found in block %s", txIndex, heights[i])
+				continue
+			}
+			data.trace = traces[txIndex]
+		}
+	})
+}
+
+// transferTrace returns the trace of a plain value transfer to an account without code,
+// which is built from the transaction itself instead of tracing it on the node.
+// It returns nil for all other transactions.
+func (w *Worker) transferTrace(tx *model.Transaction, data *fetchData) (*client.Call, error) {
+	receipt := data.receipt
+
+	// Plain transfers only consume the intrinsic gas and emit no logs
+	if receipt.GasUsed != params.TxGas || len(receipt.Logs) > 0 || receipt.ContractAddress != (common.Address{}) {
+		return nil, nil
+	}
+
+	receiver := tx.Metadata.GetString("receiver")
+	if !common.IsHexAddress(receiver) {
+		return nil, nil
+	}
+	to := common.HexToAddress(receiver)
+
+	code, err := w.evm.ContractCode(context.Background(), to, receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) > 0 {
+		return nil, nil
+	}
+
+	value, ok := new(big.Int).SetString(tx.Metadata.GetString("amount"), 10)
+	if !ok {
+		return nil, nil
+	}
+
+	return &client.Call{
+		Type:    "CALL",
+		From:    common.HexToAddress(tx.Metadata.GetString("sender")),
+		To:      to,
+		Value:   (*hexutil.Big)(value),
+		Gas:     (*hexutil.Big)(new(big.Int).SetUint64(uint64(tx.Metadata.GetInt("gas")))),
+		GasUsed: (*hexutil.Big)(new(big.Int).SetUint64(receipt.GasUsed)),
+		Input:   hexutil.Bytes{},
+		Output:  hexutil.Bytes{},
+		Calls:   []*client.Call{},
+	}, nil
+}
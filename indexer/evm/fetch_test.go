@@ -0,0 +1,326 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	corethTypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+var (
+	testSender   = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testReceiver = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testContract = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+// stubEvm serves receipts, code and traces from memory and records traced transactions
+type stubEvm struct {
+	receipts    map[common.Hash]*corethTypes.Receipt
+	code        map[common.Address][]byte
+	codeErr     error
+	traces      map[string]*client.Call
+	blockTraces map[uint64][]*client.Call
+
+	lock   sync.Mutex
+	traced []string
+}
+
+func (s *stubEvm) TransactionReceipt(ctx context.Context, hash common.Hash) (*corethTypes.Receipt, error) {
+	receipt, ok := s.receipts[hash]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return receipt, nil
+}
+
+func (s *stubEvm) ContractCode(ctx context.Context, address common.Address, block *big.Int) ([]byte, error) {
+	if s.codeErr != nil {
+		return nil, s.codeErr
+	}
+	return s.code[address], nil
+}
+
+func (s *stubEvm) TraceTransaction(ctx context.Context, hash string) (*client.Call, error) {
+	s.lock.Lock()
+	s.traced = append(s.traced, hash)
+	s.lock.Unlock()
+
+	trace, ok := s.traces[hash]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return trace, nil
+}
+
+func (s *stubEvm) TraceBlockByNumber(ctx context.Context, number *big.Int) ([]*client.Call, error) {
+	traces, ok := s.blockTraces[number.Uint64()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return traces, nil
+}
+
+func testTransfer(hash common.Hash, to common.Address, amount string) model.Transaction {
+	return model.Transaction{
+		ID:        hash.String(),
+		Timestamp: time.Unix(1640000000, 0),
+		Metadata: types.Map{
+			"sender":              testSender.String(),
+			"receiver":            to.String(),
+			"amount":              amount,
+			"gas":                 float64(21000),
+			"effective_gas_price": "25000000000",
+		},
+	}
+}
+
+func testReceipt(hash common.Hash, txIndex uint, gasUsed uint64) *corethTypes.Receipt {
+	return &corethTypes.Receipt{
+		TxHash:           hash,
+		BlockNumber:      big.NewInt(100),
+		TransactionIndex: txIndex,
+		GasUsed:          gasUsed,
+		Status:           corethTypes.ReceiptStatusSuccessful,
+	}
+}
+
+func TestTransferTrace(t *testing.T) {
+	hash := common.HexToHash("0x01")
+
+	examples := []struct {
+		name    string
+		tx      model.Transaction
+		receipt *corethTypes.Receipt
+		codeErr error
+		skip    bool
+		err     bool
+	}{
+		{
+			name:    "plain transfer",
+			tx:      testTransfer(hash, testReceiver, "1000000000000000000"),
+			receipt: testReceipt(hash, 0, 21000),
+			skip:    true,
+		},
+		{
+			name:    "more than intrinsic gas",
+			tx:      testTransfer(hash, testReceiver, "1000000000000000000"),
+			receipt: testReceipt(hash, 0, 21001),
+		},
+		{
+			name: "emits logs",
+			tx:   testTransfer(hash, testReceiver, "1000000000000000000"),
+			receipt: func() *corethTypes.Receipt {
+				r := testReceipt(hash, 0, 21000)
+				r.Logs = []*corethTypes.Log{{Address: testReceiver}}
+				return r
+			}(),
+		},
+		{
+			name: "contract creation",
+			tx:   testTransfer(hash, testReceiver, "0"),
+			receipt: func() *corethTypes.Receipt {
+				r := testReceipt(hash, 0, 21000)
+				r.ContractAddress = testContract
+				return r
+			}(),
+		},
+		{
+			name:    "receiver with code",
+			tx:      testTransfer(hash, testContract, "1000000000000000000"),
+			receipt: testReceipt(hash, 0, 21000),
+		},
+		{
+			name: "missing receiver",
+			tx: func() model.Transaction {
+				tx := testTransfer(hash, testReceiver, "1")
+				delete(tx.Metadata, "receiver")
+				return tx
+			}(),
+			receipt: testReceipt(hash, 0, 21000),
+		},
+		{
+			name:    "invalid amount",
+			tx:      testTransfer(hash, testReceiver, "n/a"),
+			receipt: testReceipt(hash, 0, 21000),
+		},
+		{
+			name:    "code lookup error",
+			tx:      testTransfer(hash, testReceiver, "1"),
+			receipt: testReceipt(hash, 0, 21000),
+			codeErr: errors.New("missing trie node"),
+			err:     true,
+		},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			w := &Worker{evm: &stubEvm{
+				code:    map[common.Address][]byte{testContract: {0x60, 0x80}},
+				codeErr: ex.codeErr,
+			}}
+
+			trace, err := w.transferTrace(&ex.tx, &fetchData{receipt: ex.receipt})
+			if ex.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if !ex.skip {
+				assert.Nil(t, trace)
+				return
+			}
+			require.NotNil(t, trace)
+			assert.Equal(t, "CALL", trace.Type)
+			assert.Equal(t, testSender, trace.From)
+			assert.Equal(t, ex.tx.Metadata.GetString("amount"), trace.Value.ToInt().String())
+			assert.Equal(t, uint64(21000), trace.GasUsed.ToInt().Uint64())
+		})
+	}
+}
+
+func TestTransferTraceMatchesNodeTrace(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	tx := testTransfer(hash, testReceiver, "1000000000000000000")
+	receipt := testReceipt(hash, 3, 21000)
+
+	// Call tracer output of the same transfer
+	nodeTrace := &client.Call{}
+	err := json.Unmarshal([]byte(`{
+		"type": "CALL",
+		"from": "0x1111111111111111111111111111111111111111",
+		"to": "0x2222222222222222222222222222222222222222",
+		"value": "0xde0b6b3a7640000",
+		"gas": "0x0",
+		"gasUsed": "0x5208",
+		"input": "0x",
+		"output": "0x"
+	}`), nodeTrace)
+	require.NoError(t, err)
+
+	w := &Worker{evm: &stubEvm{}}
+
+	trace, err := w.transferTrace(&tx, &fetchData{receipt: receipt})
+	require.NoError(t, err)
+	require.NotNil(t, trace)
+
+	skipped := &fetchData{txID: tx.ID, receipt: receipt, trace: trace}
+	traced := &fetchData{txID: tx.ID, receipt: receipt, trace: nodeTrace}
+
+	changes := prepareBalanceChanges(&tx, skipped)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, prepareBalanceChanges(&tx, traced), changes)
+
+	assert.Empty(t, prepareInternalTxs(skipped, tx.Timestamp))
+	assert.Equal(t, prepareInternalTxs(traced, tx.Timestamp), prepareInternalTxs(skipped, tx.Timestamp))
+}
+
+func TestFetchTxsDataBlockMode(t *testing.T) {
+	transferHash := common.HexToHash("0x01")
+	callHash := common.HexToHash("0x02")
+	lateHash := common.HexToHash("0x03")
+
+	transfer := testTransfer(transferHash, testReceiver, "1")
+	call := testTransfer(callHash, testContract, "0")
+	late := testTransfer(lateHash, testContract, "0")
+
+	blockTraces := []*client.Call{
+		{Type: "CALL", From: testSender, To: testReceiver},
+		{Type: "CALL", From: testSender, To: testContract},
+		{Type: "CALL", From: testSender, To: testSender},
+	}
+
+	evm := &stubEvm{
+		receipts: map[common.Hash]*corethTypes.Receipt{
+			transferHash: testReceipt(transferHash, 0, 21000),
+			callHash:     testReceipt(callHash, 2, 50000),
+			lateHash:     testReceipt(lateHash, 5, 50000),
+		},
+		code:        map[common.Address][]byte{testContract: {0x60, 0x80}},
+		blockTraces: map[uint64][]*client.Call{100: blockTraces},
+	}
+
+	w := &Worker{
+		evm:         evm,
+		traceConfig: TraceConfig{Mode: TraceModeBlock, Concurrency: 2, SkipTransfers: true},
+	}
+
+	results := w.fetchTxsData([]model.Transaction{transfer, call, late})
+	require.Len(t, results, 3)
+
+	// Skipped transfer is built from the transaction, not taken from the block trace
+	require.NoError(t, results[0].err)
+	assert.NotSame(t, blockTraces[0], results[0].trace)
+	assert.Equal(t, testReceiver, results[0].trace.To)
+
+	// Traces are matched by the receipt transaction index
+	require.NoError(t, results[1].err)
+	assert.Same(t, blockTraces[2], results[1].trace)
+
+	assert.Error(t, results[2].err)
+	assert.Nil(t, results[2].trace)
+
+	assert.Empty(t, evm.traced)
+}
+
+func TestFetchTxsDataTransactionMode(t *testing.T) {
+	transferHash := common.HexToHash("0x01")
+	callHash := common.HexToHash("0x02")
+
+	callTrace := &client.Call{Type: "CALL", From: testSender, To: testContract}
+
+	evm := &stubEvm{
+		receipts: map[common.Hash]*corethTypes.Receipt{
+			transferHash: testReceipt(transferHash, 0, 21000),
+			callHash:     testReceipt(callHash, 1, 50000),
+		},
+		code:   map[common.Address][]byte{testContract: {0x60, 0x80}},
+		traces: map[string]*client.Call{callHash.String(): callTrace},
+	}
+
+	examples := []struct {
+		name          string
+		skipTransfers bool
+		traced        []string
+	}{
+		{"skip transfers", true, []string{callHash.String()}},
+		{"trace all", false, []string{transferHash.String(), callHash.String()}},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			evm.traced = nil
+			evm.traces[transferHash.String()] = &client.Call{Type: "CALL", From: testSender, To: testReceiver}
+
+			w := &Worker{
+				evm:         evm,
+				traceConfig: TraceConfig{Mode: TraceModeTransaction, Concurrency: 1, SkipTransfers: ex.skipTransfers},
+			}
+
+			results := w.fetchTxsData([]model.Transaction{
+				testTransfer(transferHash, testReceiver, "1"),
+				testTransfer(callHash, testContract, "0"),
+			})
+			require.Len(t, results, 2)
+
+			for _, result := range results {
+				require.NoError(t, result.err)
+				require.NotNil(t, result.trace)
+			}
+			assert.Same(t, callTrace, results[1].trace)
+			assert.ElementsMatch(t, ex.traced, evm.traced)
+		})
+	}
+}
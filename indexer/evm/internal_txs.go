@@ -0,0 +1,69 @@
+package evm
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+// createInternalTxs stores the nested calls of the transaction trace
+func (w *Worker) createInternalTxs(data *fetchData, timestamp time.Time) error {
+	return w.db.EvmInternalTxs.Import(prepareInternalTxs(data, timestamp))
+}
+
+// prepareInternalTxs returns the nested calls of the transaction trace.
+// The top level call is the transaction itself and is not included.
+func prepareInternalTxs(data *fetchData, timestamp time.Time) []model.EvmInternalTx {
+	if data.trace == nil {
+		return nil
+	}
+
+	calls := client.FlattenTraces(data.trace)
+	records := make([]model.EvmInternalTx, 0, len(calls))
+
+	for idx, call := range calls {
+		if len(call.Path) == 0 {
+			continue
+		}
+
+		path := make(pq.Int64Array, len(call.Path))
+		for i, pos := range call.Path {
+			path[i] = int64(pos)
+		}
+
+		record := model.EvmInternalTx{
+			TxHash:      data.receipt.TxHash.String(),
+			TraceIndex:  idx,
+			TracePath:   path,
+			BlockHeight: data.receipt.BlockNumber.Uint64(),
+			Type:        call.Type,
+			From:        call.From.String(),
+			To:          call.To.String(),
+			Value:       types.Amount{Int: call.Value},
+			Gas:         bigToUint64(call.Gas),
+			GasUsed:     bigToUint64(call.GasUsed),
+			Revert:      call.Revert,
+			Timestamp:   timestamp,
+		}
+		if call.Error != "" {
+			errMsg := call.Error
+			record.Error = &errMsg
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func bigToUint64(val *big.Int) uint64 {
+	if val == nil || !val.IsUint64() {
+		return 0
+	}
+	return val.Uint64()
+}
@@ -0,0 +1,153 @@
+package evm
+
+import (
+	"math/big"
+	"time"
+
+	corethTypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+var (
+	// TransferSingle(address,address,address,uint256,uint256) event signature
+	transferSingleTopic = common.HexToHash("0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+
+	// TransferBatch(address,address,address,uint256[],uint256[]) event signature
+	transferBatchTopic = common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+
+	transferBatchArguments abi.Arguments
+)
+
+func init() {
+	arrayType, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	transferBatchArguments = abi.Arguments{{Type: arrayType}, {Type: arrayType}}
+}
+
+// createNftTransfers stores ERC-721 and ERC-1155 transfers found in the receipt logs
+// and updates owners of the transferred tokens
+func (w *Worker) createNftTransfers(logs []*corethTypes.Log, timestamp time.Time) error {
+	transfers := []model.NftTransfer{}
+	tokenTypes := map[string]string{}
+
+	for _, logEntry := range logs {
+		if len(logEntry.Topics) != 4 {
+			continue
+		}
+
+		var (
+			tokenType string
+			entries   []model.NftTransfer
+		)
+
+		switch logEntry.Topics[0] {
+		case transferTopic:
+			tokenType = model.TokenTypeERC721
+			entries = decodeERC721Transfer(logEntry)
+		case transferSingleTopic:
+			tokenType = model.TokenTypeERC1155
+			entries = decodeTransferSingle(logEntry)
+		case transferBatchTopic:
+			tokenType = model.TokenTypeERC1155
+			entries = decodeTransferBatch(logEntry)
+		default:
+			continue
+		}
+
+		for _, entry := range entries {
+			entry.Timestamp = timestamp
+			transfers = append(transfers, entry)
+		}
+		if len(entries) > 0 {
+			tokenTypes[logEntry.Address.String()] = tokenType
+		}
+	}
+
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	for address, tokenType := range tokenTypes {
+		if err := w.ensureToken(address, tokenType, timestamp); err != nil {
+			return err
+		}
+	}
+
+	return w.db.Nfts.ImportTransfers(transfers)
+}
+
+func decodeERC721Transfer(logEntry *corethTypes.Log) []model.NftTransfer {
+	if len(logEntry.Data) != 0 {
+		return nil
+	}
+
+	transfer := newNftTransfer(logEntry, logEntry.Topics[1], logEntry.Topics[2])
+	transfer.TokenID = types.Amount{Int: logEntry.Topics[3].Big()}
+	transfer.Amount = types.NewInt64Amount(1)
+
+	return []model.NftTransfer{transfer}
+}
+
+func decodeTransferSingle(logEntry *corethTypes.Log) []model.NftTransfer {
+	if len(logEntry.Data) != 64 {
+		return nil
+	}
+
+	transfer := newNftTransfer(logEntry, logEntry.Topics[2], logEntry.Topics[3])
+	transfer.Operator = nftOperator(logEntry)
+	transfer.TokenID = types.Amount{Int: new(big.Int).SetBytes(logEntry.Data[:32])}
+	transfer.Amount = types.Amount{Int: new(big.Int).SetBytes(logEntry.Data[32:])}
+
+	return []model.NftTransfer{transfer}
+}
+
+func decodeTransferBatch(logEntry *corethTypes.Log) []model.NftTransfer {
+	values, err := transferBatchArguments.Unpack(logEntry.Data)
+	if err != nil || len(values) != 2 {
+		return nil
+	}
+
+	ids, ok := values[0].([]*big.Int)
+	if !ok {
+		return nil
+	}
+	amounts, ok := values[1].([]*big.Int)
+	if !ok || len(ids) != len(amounts) {
+		return nil
+	}
+
+	result := make([]model.NftTransfer, len(ids))
+	for idx := range ids {
+		transfer := newNftTransfer(logEntry, logEntry.Topics[2], logEntry.Topics[3])
+		transfer.BatchIndex = idx
+		transfer.Operator = nftOperator(logEntry)
+		transfer.TokenID = types.Amount{Int: ids[idx]}
+		transfer.Amount = types.Amount{Int: amounts[idx]}
+
+		result[idx] = transfer
+	}
+
+	return result
+}
+
+func newNftTransfer(logEntry *corethTypes.Log, from common.Hash, to common.Hash) model.NftTransfer {
+	return model.NftTransfer{
+		TxHash:      logEntry.TxHash.String(),
+		BlockHeight: logEntry.BlockNumber,
+		LogIndex:    int(logEntry.Index),
+		Token:       logEntry.Address.String(),
+		From:        common.BytesToAddress(from.Bytes()).String(),
+		To:          common.BytesToAddress(to.Bytes()).String(),
+	}
+}
+
+func nftOperator(logEntry *corethTypes.Log) *string {
+	operator := common.BytesToAddress(logEntry.Topics[1].Bytes()).String()
+	return &operator
+}
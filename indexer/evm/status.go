@@ -0,0 +1,31 @@
+package evm
+
+import (
+	corethTypes "github.com/ava-labs/coreth/core/types"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+// updateTxStatus marks the transaction as reverted when its execution failed
+func (w *Worker) updateTxStatus(tx *model.Transaction, data *fetchData) error {
+	reverted := data.receipt.Status == corethTypes.ReceiptStatusFailed
+	if data.trace != nil && data.trace.Revert {
+		reverted = true
+	}
+	if !reverted {
+		return nil
+	}
+
+	meta := types.Map{}
+	if data.trace != nil {
+		if data.trace.Error != "" {
+			meta["error"] = data.trace.Error
+		}
+		if data.trace.RevertReason != "" {
+			meta["revert_reason"] = data.trace.RevertReason
+		}
+	}
+
+	return w.db.Transactions.UpdateStatus(tx.ID, model.TxStatusReverted, meta)
+}
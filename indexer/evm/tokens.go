@@ -0,0 +1,86 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	corethTypes "github.com/ava-labs/coreth/core/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+var (
+	// Transfer(address,address,uint256) event signature
+	transferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+)
+
+// createTokenTransfers stores ERC-20 transfers found in the receipt logs
+func (w *Worker) createTokenTransfers(logs []*corethTypes.Log, timestamp time.Time) error {
+	transfers := []model.TokenTransfer{}
+
+	for _, logEntry := range logs {
+		// ERC-721 transfers share the signature but index the token ID as the 4th topic
+		if len(logEntry.Topics) != 3 || logEntry.Topics[0] != transferTopic || len(logEntry.Data) != 32 {
+			continue
+		}
+
+		transfers = append(transfers, model.TokenTransfer{
+			TxHash:      logEntry.TxHash.String(),
+			BlockHeight: logEntry.BlockNumber,
+			LogIndex:    int(logEntry.Index),
+			Token:       logEntry.Address.String(),
+			From:        common.BytesToAddress(logEntry.Topics[1].Bytes()).String(),
+			To:          common.BytesToAddress(logEntry.Topics[2].Bytes()).String(),
+			Amount:      types.Amount{Int: new(big.Int).SetBytes(logEntry.Data)},
+			Timestamp:   timestamp,
+		})
+	}
+
+	for _, transfer := range transfers {
+		if err := w.ensureToken(transfer.Token, model.TokenTypeERC20, timestamp); err != nil {
+			return err
+		}
+	}
+
+	return w.db.Tokens.ImportTransfers(transfers)
+}
+
+// ensureToken creates the token record with metadata fetched from the contract on first sight
+func (w *Worker) ensureToken(address string, tokenType string, timestamp time.Time) error {
+	if w.knownTokens[address] {
+		return nil
+	}
+
+	_, err := w.db.Tokens.Get(address)
+	if err == nil {
+		w.knownTokens[address] = true
+		return nil
+	}
+	if err != store.ErrNotFound {
+		return err
+	}
+
+	metadata, err := w.rpc.Evm.TokenMetadata(context.Background(), address)
+	if err != nil {
+		return err
+	}
+
+	token := &model.Token{
+		Address:   address,
+		Type:      tokenType,
+		Name:      metadata.Name,
+		Symbol:    metadata.Symbol,
+		Decimals:  metadata.Decimals,
+		CreatedAt: timestamp,
+	}
+	if err := w.db.Tokens.Create(token); err != nil {
+		return err
+	}
+
+	w.knownTokens[address] = true
+	return nil
+}
@@ -20,10 +20,14 @@ import (
 type Worker struct {
 	log           *logrus.Logger
 	rpc           *client.Client
+	evm           evmClient
 	db            *store.DB
 	chain         string
 	status        *model.SyncStatus
 	syncStatusKey string
+	knownTokens   map[string]bool
+	knownPairs    map[string]*model.DexPair
+	traceConfig   TraceConfig
 
 	errWaitTime time.Duration
 	syncTime    time.Duration
@@ -37,13 +41,17 @@ type fetchData struct {
 	trace   *client.Call
 }
 
-func NewWorker(db *store.DB, rpc *client.Client, log *logrus.Logger, chain string) Worker {
+func NewWorker(db *store.DB, rpc *client.Client, log *logrus.Logger, chain string, traceConfig TraceConfig) Worker {
 	return Worker{
 		db:            db,
 		rpc:           rpc,
+		evm:           &rpc.Evm,
 		log:           log,
 		chain:         chain,
 		syncStatusKey: fmt.Sprintf("%s_evm", chain),
+		knownTokens:   map[string]bool{},
+		knownPairs:    map[string]*model.DexPair{},
+		traceConfig:   traceConfig,
 
 		errWaitTime: time.Second,
 		syncTime:    time.Second * 3,
@@ -119,7 +127,6 @@ func (w *Worker) Run() error {
 
 	page := 1
 	limit := 100
-	maxConcurrency := 50
 
 	for {
 		search := store.TxSearchInput{
@@ -144,28 +151,19 @@ func (w *Worker) Run() error {
 			break
 		}
 
-		results := []fetchData{}
-		resultsLock := sync.Mutex{}
-
-		txIDS := make([]string, len(txSearch.Transactions))
-		for idx, tx := range txSearch.Transactions {
-			txIDS[idx] = tx.ID
+		txsByID := make(map[string]*model.Transaction, len(txSearch.Transactions))
+		for idx := range txSearch.Transactions {
+			tx := &txSearch.Transactions[idx]
+			txsByID[tx.ID] = tx
 		}
 
 		// Perform transaction receipt and trace fetches in parallel.
-		doConcurrently(txIDS, maxConcurrency, func(txID string) {
-			data := fetchData{}
-			w.fetchTxData(txID, &data)
-
-			resultsLock.Lock()
-			results = append(results, data)
-			resultsLock.Unlock()
-		})
+		results := w.fetchTxsData(txSearch.Transactions)
 
 		for _, result := range results {
 			if result.err != nil {
 				w.log.WithField("tx_id", result.txID).WithError(result.err).Error("data fetch failed")
-				return err
+				return result.err
 			}
 
 			traceData, err := json.Marshal(result.trace)
@@ -183,7 +181,29 @@ func (w *Worker) Run() error {
 				return err
 			}
 
-			if err := w.createReceiptAndLogs(&result); err != nil {
+			tx := txsByID[result.txID]
+
+			if err := w.createInternalTxs(&result, tx.Timestamp); err != nil {
+				return err
+			}
+
+			if err := w.createReceiptAndLogs(&result, tx.Timestamp); err != nil {
+				return err
+			}
+
+			if err := w.createContracts(&result, tx.Timestamp); err != nil {
+				return err
+			}
+
+			if err := w.updateTxFee(tx, result.receipt); err != nil {
+				return err
+			}
+
+			if err := w.updateTxStatus(tx, &result); err != nil {
+				return err
+			}
+
+			if err := w.createBalanceChanges(tx, &result); err != nil {
 				return err
 			}
 
@@ -202,24 +222,6 @@ func (w *Worker) Run() error {
 	return w.db.Platform.UpdateSyncStatus(status)
 }
 
-func (w *Worker) fetchTxData(txID string, data *fetchData) {
-	data.txID = txID
-
-	receipt, err := w.rpc.Evm.TransactionReceipt(context.Background(), common.HexToHash(txID))
-	if err != nil {
-		data.err = err
-		return
-	}
-	data.receipt = receipt
-
-	trace, err := w.rpc.Evm.TraceTransaction(context.Background(), txID)
-	if err != nil {
-		data.err = err
-		return
-	}
-	data.trace = trace
-}
-
 var (
 	cachedBlock     *corethTypes.Header
 	cachedBlockTime time.Time
@@ -264,8 +266,9 @@ func (w *Worker) getSyncStatus() (*model.SyncStatus, error) {
 	return status, nil
 }
 
-func (w *Worker) createReceiptAndLogs(data *fetchData) error {
+func (w *Worker) createReceiptAndLogs(data *fetchData, timestamp time.Time) error {
 	logsBatch := make([]model.EvmLog, len(data.receipt.Logs))
+	logRecords := make([]model.EvmLogRecord, len(data.receipt.Logs))
 
 	for idx, logEntry := range data.receipt.Logs {
 		topics := pq.StringArray{}
@@ -273,6 +276,21 @@ func (w *Worker) createReceiptAndLogs(data *fetchData) error {
 			topics = append(topics, topic.String())
 		}
 
+		logRecords[idx] = model.EvmLogRecord{
+			TxHash:      data.receipt.TxHash.String(),
+			BlockHeight: logEntry.BlockNumber,
+			LogIndex:    int(logEntry.Index),
+			TxIndex:     int(logEntry.TxIndex),
+			Address:     logEntry.Address.String(),
+			Topic0:      topicAt(topics, 0),
+			Topic1:      topicAt(topics, 1),
+			Topic2:      topicAt(topics, 2),
+			Topic3:      topicAt(topics, 3),
+			Data:        common.Bytes2Hex(logEntry.Data),
+			Removed:     logEntry.Removed,
+			Timestamp:   timestamp,
+		}
+
 		logsBatch[idx] = model.EvmLog{
 			Idx:     int(logEntry.Index),
 			TxIdx:   int(logEntry.TxIndex),
@@ -296,11 +314,44 @@ func (w *Worker) createReceiptAndLogs(data *fetchData) error {
 		Logs:            string(logsData),
 	}
 
-	return w.db.Platform.CreateEvmReceipt(receipt)
+	if err := w.db.Platform.CreateEvmReceipt(receipt); err != nil {
+		return err
+	}
+
+	if err := w.db.EvmLogs.Import(logRecords); err != nil {
+		return err
+	}
+
+	if err := w.createTokenTransfers(data.receipt.Logs, timestamp); err != nil {
+		return err
+	}
+
+	if err := w.createNftTransfers(data.receipt.Logs, timestamp); err != nil {
+		return err
+	}
+
+	if err := w.createTokenApprovals(data.receipt.Logs, timestamp); err != nil {
+		return err
+	}
+
+	origin := ""
+	if data.trace != nil {
+		origin = data.trace.From.String()
+	}
+
+	return w.createDexEvents(data.receipt.Logs, origin, timestamp)
+}
+
+func topicAt(topics []string, idx int) *string {
+	if idx < len(topics) {
+		return &topics[idx]
+	}
+	return nil
 }
 
-func doConcurrently(items []string, maxConcurrency int, workFn func(string)) {
-	queue := make(chan string)
+// doConcurrently runs the work function for each item index with a limited number of goroutines
+func doConcurrently(count int, maxConcurrency int, workFn func(int)) {
+	queue := make(chan int)
 
 	wg := &sync.WaitGroup{}
 	wg.Add(maxConcurrency)
@@ -315,8 +366,8 @@ func doConcurrently(items []string, maxConcurrency int, workFn func(string)) {
 		}()
 	}
 
-	for _, item := range items {
-		queue <- item
+	for idx := 0; idx < count; idx++ {
+		queue <- idx
 	}
 
 	close(queue)
@@ -65,6 +65,36 @@ func initValidator(validator *client.Validator, ts time.Time) (*model.Validator,
 	}, nil
 }
 
+func initPendingValidator(validator *client.Validator, ts time.Time) (*model.PendingValidator, error) {
+	startTime, err := util.ParseUnixTime(validator.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := util.ParseUnixTime(validator.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationFee, err := util.ParseFloat32(validator.DelegationFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.PendingValidator{
+		TxID:            validator.TxID,
+		NodeID:          validator.NodeID,
+		StakeAmount:     types.NewAmount(validator.StakeAmount),
+		DelegationFee:   delegationFee,
+		DelegatedAmount: types.NewInt64Amount(0), // filled later in the pipeline
+		Status:          model.ValidatorStatusPending,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		CreatedAt:       ts,
+		UpdatedAt:       ts,
+	}, nil
+}
+
 func initDelegation(delegator *client.Delegator) (result model.Delegation, err error) {
 	amount := types.NewAmount(delegator.StakeAmount)
 	reward := types.NewAmount(delegator.PotentialReward)
@@ -79,27 +109,40 @@ func initDelegation(delegator *client.Delegator) (result model.Delegation, err e
 		return result, err
 	}
 
+	// Pending delegators are reported without the reward owner
+	var rewardAddress string
+	if len(delegator.RewardOwner.Addresses) > 0 {
+		rewardAddress = delegator.RewardOwner.Addresses[0]
+	}
+
 	return model.Delegation{
 		ReferenceID:     delegator.TxID,
 		NodeID:          delegator.NodeID,
 		StakeAmount:     amount,
 		PotentialReward: reward,
-		RewardAddress:   delegator.RewardOwner.Addresses[0],
+		RewardAddress:   rewardAddress,
 		Active:          true,
 		ActiveStartTime: startTime,
 		ActiveEndTime:   endTime,
+		Status:          model.DelegationStatusActive,
 	}, nil
 }
 
 func initDelegations(validator *client.Validator, ts time.Time) ([]model.Delegation, error) {
 	result := []model.Delegation{}
 
+	fee, err := util.ParseFloat32(validator.DelegationFee)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, d := range validator.Delegators {
 		delegator, err := initDelegation(&d)
 		if err != nil {
 			return nil, err
 		}
 		delegator.CreatedAt = ts
+		delegator.DelegationFee = &fee
 
 		result = append(result, delegator)
 	}
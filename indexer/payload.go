@@ -46,6 +46,9 @@ type Payload struct {
 	Validators    []model.Validator
 	ValidatorSeq  []model.ValidatorSeq
 	Delegations   []model.Delegation
+
+	PendingValidatorRecords []model.PendingValidator
+	PendingDelegations      []model.Delegation
 }
 
 func NewPayload() *Payload {
@@ -12,7 +12,6 @@ import (
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
 	"github.com/sirupsen/logrus"
 
-	"github.com/figment-networks/avalanche-indexer/client"
 	"github.com/figment-networks/avalanche-indexer/indexer/shared"
 	"github.com/figment-networks/avalanche-indexer/model"
 	"github.com/figment-networks/avalanche-indexer/store"
@@ -20,18 +19,20 @@ import (
 )
 
 const (
-	containerType  = "P"
+	ContainerType  = "P"
 	fetchBatchSize = 256
 )
 
 type Worker struct {
-	chain       string
-	avaxAsset   string
-	codec       codec.Manager
-	store       *store.DB
-	indexClient *client.IndexClient
-	log         *logrus.Logger
-	status      *model.SyncStatus
+	chain     string
+	avaxAsset string
+	codec     codec.Manager
+	store     *store.DB
+	source    shared.ContainerSource
+	log       *logrus.Logger
+	status    *model.SyncStatus
+
+	failurePolicy shared.FailurePolicy
 }
 
 type BlockData struct {
@@ -44,19 +45,22 @@ type BlockData struct {
 }
 
 func NewWorker(
-	indexClient *client.IndexClient,
+	source shared.ContainerSource,
 	store *store.DB,
 	codec codec.Manager,
 	chain string,
 	asset string,
+	failurePolicy shared.FailurePolicy,
 ) Worker {
 	return Worker{
-		chain:       chain,
-		avaxAsset:   asset,
-		store:       store,
-		codec:       codec,
-		log:         logrus.StandardLogger(),
-		indexClient: indexClient,
+		chain:     chain,
+		avaxAsset: asset,
+		store:     store,
+		codec:     codec,
+		log:       logrus.StandardLogger(),
+		source:    source,
+
+		failurePolicy: failurePolicy,
 	}
 }
 
@@ -97,27 +101,38 @@ func (w *Worker) Start(ctx context.Context) {
 }
 
 func (w *Worker) Run() error {
-	status, err := shared.GetSyncStatus(w.indexClient, w.store, containerType, w.chain)
+	status, err := shared.GetSyncStatus(w.source, w.store)
 	if err != nil {
 		return err
 	}
 	w.status = status
 
+	if err := shared.RetryFailedContainers(w.status, w.source, w.store, w.failurePolicy, w.processMessage); err != nil {
+		return err
+	}
+
 	if w.status.AtTip() {
 		return nil
 	}
 
 	return shared.ProcessContainerRange(
 		w.status,
-		w.indexClient,
+		w.source,
 		w.store,
-		containerType,
 		fetchBatchSize,
-		w.ProcessMessage,
+		w.failurePolicy,
+		w.processMessage,
 	)
 }
 
+// ProcessMessage processes a single container within a database transaction
 func (w Worker) ProcessMessage(message *model.RawMessage) error {
+	return w.store.Transaction(func(db *store.DB) error {
+		return w.processMessage(db, message)
+	})
+}
+
+func (w Worker) processMessage(db *store.DB, message *model.RawMessage) error {
 	var (
 		raw []byte
 		err error
@@ -133,7 +148,7 @@ func (w Worker) ProcessMessage(message *model.RawMessage) error {
 		return err
 	}
 
-	return w.saveBlockData(blockData)
+	return w.saveBlockData(db, blockData)
 }
 
 func (w Worker) prepareBlockData(raw []byte, blockTime time.Time) (*BlockData, error) {
@@ -181,12 +196,12 @@ func (w Worker) prepareBlockData(raw []byte, blockTime time.Time) (*BlockData, e
 	return blockData, nil
 }
 
-func (w Worker) saveBlockData(data *BlockData) error {
+func (w Worker) saveBlockData(db *store.DB, data *BlockData) error {
 	w.log.
 		WithFields(logrus.Fields{"block": data.Block.ID, "height": data.Block.Height}).
 		Debug("importing block")
 
-	if err := w.store.Platform.CreateBlock(data.Block); err != nil {
+	if err := db.Platform.CreateBlock(data.Block); err != nil {
 		return err
 	}
 
@@ -195,11 +210,11 @@ func (w Worker) saveBlockData(data *BlockData) error {
 			WithFields(logrus.Fields{"type": tx.Type, "id": tx.ID}).
 			Debug("importing transaction")
 
-		if err := w.store.Platform.CreateTransaction(tx); err != nil {
+		if err := db.Platform.CreateTransaction(tx); err != nil {
 			return err
 		}
 
-		if err := w.store.Platform.CreateTxOutputs(tx.Outputs); err != nil {
+		if err := db.Platform.CreateTxOutputs(tx.Outputs); err != nil {
 			return err
 		}
 
@@ -208,24 +223,24 @@ func (w Worker) saveBlockData(data *BlockData) error {
 			spentIDs[idx] = input.ID
 		}
 
-		if err := w.store.Platform.CreateTxInputs(spentIDs, tx.ID); err != nil {
+		if err := db.Platform.CreateTxInputs(spentIDs, tx.ID); err != nil {
 			return err
 		}
 
-		if err := w.store.Platform.MarkOutputsSpent(spentIDs, tx.ID, tx.Timestamp); err != nil {
+		if err := db.Platform.MarkOutputsSpent(spentIDs, tx.ID, tx.Timestamp); err != nil {
 			return err
 		}
 	}
 
 	if data.Chain != nil {
-		if err := w.store.Platform.CreateChain(data.Chain); err != nil {
+		if err := db.Platform.CreateChain(data.Chain); err != nil {
 			return err
 		}
 	}
 
 	if data.RewardsOwner != nil {
 		w.log.WithField("tx", data.RewardsOwner.ID).Debug("creating rewards owner records")
-		if err := w.store.Platform.CreateRewardsOwner(data.RewardsOwner); err != nil {
+		if err := db.Platform.CreateRewardsOwner(data.RewardsOwner); err != nil {
 			return err
 		}
 	}
@@ -2,23 +2,189 @@ package rewards
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/indexer/codec"
+	"github.com/figment-networks/avalanche-indexer/indexer/shared"
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+const (
+	// number of reward transactions processed in a single run
+	batchSize = 100
 )
 
+// Worker stores reward UTXOs paid out by the P-chain reward validator transactions
 type Worker struct {
-	log *logrus.Logger
+	log   *logrus.Logger
+	rpc   *client.Client
+	db    *store.DB
+	chain string
+
+	errWaitTime time.Duration
+	syncTime    time.Duration
+	cycleTime   time.Duration
 }
 
-func NewWorker() Worker {
-	return Worker{}
+func NewWorker(db *store.DB, rpc *client.Client, log *logrus.Logger, chain string) Worker {
+	return Worker{
+		db:    db,
+		rpc:   rpc,
+		log:   log,
+		chain: chain,
+
+		errWaitTime: time.Second,
+		syncTime:    time.Second * 10,
+		cycleTime:   time.Millisecond * 10,
+	}
 }
 
 func (w *Worker) Start(ctx context.Context) {
+	w.log.WithField("chain", w.chain).Info("starting rewards worker")
+
+	timer := time.NewTimer(time.Second)
+	defer func() {
+		timer.Stop()
+		w.log.WithField("chain", w.chain).Info("rewards worker stopped")
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
+			w.log.WithField("chain", w.chain).Info("stopping rewards worker")
 			return
+		case <-timer.C:
+			count, err := w.process()
+			if err != nil {
+				w.log.WithField("chain", w.chain).WithError(err).Info("rewards worker run failed")
+				timer.Reset(w.errWaitTime)
+				break
+			}
+
+			if count > 0 {
+				w.log.WithField("chain", w.chain).WithField("count", count).Info("processed rewards")
+			}
+
+			if count < batchSize {
+				timer.Reset(w.syncTime)
+			} else {
+				timer.Reset(w.cycleTime)
+			}
+		}
+	}
+}
+
+// Run processes all pending reward transactions
+func (w *Worker) Run() error {
+	for {
+		count, err := w.process()
+		if err != nil {
+			return err
 		}
+		if count < batchSize {
+			return nil
+		}
+	}
+}
+
+// process stores rewards of the next batch of pending reward transactions
+func (w *Worker) process() (int, error) {
+	txs, err := w.db.Rewards.PendingTxs(w.chain, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for idx := range txs {
+		if err := w.processTx(&txs[idx]); err != nil {
+			return idx, err
+		}
+	}
+
+	return len(txs), nil
+}
+
+func (w *Worker) processTx(tx *model.Transaction) error {
+	if tx.ReferenceTxID == nil {
+		return errors.New("reward transaction is missing the staking transaction reference")
 	}
+	stakingTxID := *tx.ReferenceTxID
+
+	outputs, err := fetchOutputs(w.rpc, w.chain, stakingTxID)
+	if err != nil {
+		return err
+	}
+
+	outputIDs := make([]string, len(outputs))
+	for idx, output := range outputs {
+		outputIDs[idx] = output.ID
+	}
+
+	reward := &model.Reward{
+		ID:            stakingTxID,
+		TransactionID: tx.ID,
+		Rewarded:      len(outputs) > 0,
+		RewardedAt:    tx.Timestamp,
+		ProcessedAt:   time.Now(),
+	}
+
+	return w.db.Transaction(func(db *store.DB) error {
+		if err := db.Platform.CreateTxOutputs(outputs); err != nil {
+			return err
+		}
+		// Rewards may be spent by transactions indexed before the worker got to them
+		if err := db.Rewards.MarkSpentOutputs(outputIDs); err != nil {
+			return err
+		}
+		return db.Rewards.Create(reward)
+	})
+}
+
+// fetchOutputs returns the reward outputs paid out for the staking transaction
+func fetchOutputs(rpc *client.Client, chain string, stakingTxID string) ([]model.Output, error) {
+	resp, err := rpc.Platform.GetRewardUTXOs(stakingTxID)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]model.Output, len(resp.UTXOs))
+	for idx, data := range resp.UTXOs {
+		output, err := decodeOutput(chain, data)
+		if err != nil {
+			return nil, err
+		}
+		outputs[idx] = *output
+	}
+
+	return outputs, nil
+}
+
+// decodeOutput decodes the hex encoded reward UTXO into an output of the staking transaction
+func decodeOutput(chain string, data string) (*model.Output, error) {
+	raw, err := formatting.Decode(formatting.Hex, data)
+	if err != nil {
+		return nil, err
+	}
+
+	utxo := &avax.UTXO{}
+	if _, err := codec.PVM.Unmarshal(raw, utxo); err != nil {
+		return nil, err
+	}
+
+	output, err := shared.PrepareOutput(utxo.Out, utxo.AssetID().String(), int(utxo.OutputIndex), utxo.TxID)
+	if err != nil {
+		return nil, err
+	}
+
+	output.Chain = chain
+	output.Type = model.OutTypeReward
+	output.Reward = true
+
+	return output, nil
 }
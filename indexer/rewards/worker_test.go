@@ -0,0 +1,91 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/avalanche-indexer/indexer/codec"
+	"github.com/figment-networks/avalanche-indexer/indexer/shared"
+	"github.com/figment-networks/avalanche-indexer/model"
+)
+
+func testRewardUTXO(t *testing.T, txID ids.ID, assetID ids.ID, addr ids.ShortID) string {
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: txID, OutputIndex: 2},
+		Asset:  avax.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1500000,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr},
+			},
+		},
+	}
+
+	raw, err := codec.PVM.Marshal(platformvm.CodecVersion, utxo)
+	require.NoError(t, err)
+
+	data, err := formatting.EncodeWithChecksum(formatting.Hex, raw)
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestDecodeOutput(t *testing.T) {
+	shared.SetBech32HRP(constants.MainnetID)
+
+	txID := ids.ID{1, 2, 3}
+	assetID := ids.ID{4, 5, 6}
+	addr := ids.ShortID{7, 8, 9}
+
+	expectedAddr, err := formatting.FormatBech32(constants.MainnetHRP, addr.Bytes())
+	require.NoError(t, err)
+
+	t.Run("transfer output", func(t *testing.T) {
+		output, err := decodeOutput("P", testRewardUTXO(t, txID, assetID, addr))
+		require.NoError(t, err)
+
+		assert.Equal(t, txID.Prefix(2).String(), output.ID)
+		assert.Equal(t, txID.String(), output.TxID)
+		assert.Equal(t, uint64(2), output.Index)
+		assert.Equal(t, "P", output.Chain)
+		assert.Equal(t, assetID.String(), output.Asset)
+		assert.Equal(t, model.OutTypeReward, output.Type)
+		assert.True(t, output.Reward)
+		assert.Equal(t, uint64(1500000), output.Amount)
+		assert.Equal(t, uint32(1), output.Threshold)
+		assert.Equal(t, []string{expectedAddr}, []string(output.Addresses))
+	})
+
+	t.Run("invalid encoding", func(t *testing.T) {
+		_, err := decodeOutput("P", "not-hex")
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		data := testRewardUTXO(t, txID, assetID, addr)
+		last := "0"
+		if data[len(data)-1] == '0' {
+			last = "1"
+		}
+
+		_, err := decodeOutput("P", data[:len(data)-1]+last)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid utxo", func(t *testing.T) {
+		data, err := formatting.EncodeWithChecksum(formatting.Hex, []byte{0, 0, 1})
+		require.NoError(t, err)
+
+		_, err = decodeOutput("P", data)
+		assert.Error(t, err)
+	})
+}
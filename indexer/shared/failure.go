@@ -0,0 +1,125 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+const (
+	// FailurePolicyHalt stops the chain at the failed container until it's resolved
+	FailurePolicyHalt = "halt"
+
+	// FailurePolicySkip records the failed container and moves on
+	FailurePolicySkip = "skip"
+
+	// FailurePolicyRetry retries the failed container before skipping it
+	FailurePolicyRetry = "retry"
+)
+
+// FailurePolicy controls how the chain workers handle containers that fail to process
+type FailurePolicy struct {
+	Mode        string
+	MaxAttempts int
+}
+
+func (p FailurePolicy) Validate() error {
+	switch p.Mode {
+	case FailurePolicyHalt, FailurePolicySkip:
+	case FailurePolicyRetry:
+		if p.MaxAttempts < 1 {
+			return fmt.Errorf("max attempts must be set for %q failure policy", p.Mode)
+		}
+	default:
+		return fmt.Errorf("invalid failure policy: %q", p.Mode)
+	}
+	return nil
+}
+
+// handleFailure stores the failed container and decides whether the worker should skip it
+func handleFailure(db *store.DB, chain string, message *model.RawMessage, policy FailurePolicy, failure error) (bool, error) {
+	status := model.FailedContainerStatusFailed
+	if policy.Mode == FailurePolicySkip {
+		status = model.FailedContainerStatusSkipped
+	}
+
+	record, err := db.FailedContainers.Record(&model.FailedContainer{
+		Chain:     chain,
+		IndexID:   int64(message.IndexID),
+		Data:      message.Data,
+		Error:     failure.Error(),
+		Status:    status,
+		Timestamp: message.CreatedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	switch record.Status {
+	case model.FailedContainerStatusResolved, model.FailedContainerStatusSkipped:
+		return true, nil
+	}
+
+	if policy.Mode == FailurePolicyRetry && record.Attempts >= policy.MaxAttempts {
+		return true, db.FailedContainers.UpdateStatus(record.ID, model.FailedContainerStatusSkipped)
+	}
+
+	return false, nil
+}
+
+// RetryFailedContainers reprocesses failed containers scheduled for a retry. A container
+// the sync status is halted at is acked and moves the status past it once processed, so
+// the worker doesn't process it twice. Failed retries are recorded under the given policy.
+func RetryFailedContainers(
+	status *model.SyncStatus,
+	source ContainerSource,
+	db *store.DB,
+	policy FailurePolicy,
+	handlerFn HandlerFunc,
+) error {
+	containers, err := db.FailedContainers.Search(&store.FailedContainersSearch{
+		Chain:  status.ID,
+		Status: model.FailedContainerStatusRetry,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		message := container.RawMessage()
+
+		next := *status
+		if container.IndexID == status.NextID() {
+			next.IndexID = container.IndexID
+			next.IndexTime = container.Timestamp
+		}
+
+		err := db.Transaction(func(tx *store.DB) error {
+			if err := handlerFn(tx, message); err != nil {
+				return err
+			}
+			if err := tx.FailedContainers.UpdateStatus(container.ID, model.FailedContainerStatusResolved); err != nil {
+				return err
+			}
+			if next.IndexID == status.IndexID {
+				return nil
+			}
+			if err := source.Ack(tx, message); err != nil {
+				return err
+			}
+			return tx.Platform.UpdateSyncStatus(&next)
+		})
+
+		if err != nil {
+			if _, err := handleFailure(db, status.ID, message, policy, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		*status = next
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package shared
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+// messagesStore is the part of the raw messages store used by the messages source
+type messagesStore interface {
+	GetTopicByChain(chain string) (*model.RawMessageTopic, error)
+	GetMessages(input store.GetRawMessagesInput) ([]model.RawMessage, error)
+	LastMessage(topic int) (*model.RawMessage, error)
+}
+
+// MessagesSource reads containers pushed by the node IPC and stored in raw messages table
+type MessagesSource struct {
+	messages messagesStore
+	chain    string
+}
+
+func NewMessagesSource(db *store.DB, chain string) MessagesSource {
+	return MessagesSource{
+		messages: db.RawMessages,
+		chain:    chain,
+	}
+}
+
+func (s MessagesSource) StatusKey() string {
+	return fmt.Sprintf("%s_ipc", s.chain)
+}
+
+func (s MessagesSource) Tip() (int64, time.Time, error) {
+	topic, err := s.topic()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	lastMessage, err := s.messages.LastMessage(topic.ID)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return 0, time.Time{}, nil
+		}
+		return 0, time.Time{}, err
+	}
+
+	return int64(lastMessage.ID), lastMessage.CreatedAt, nil
+}
+
+func (s MessagesSource) Range(start int64, limit int) ([]*model.RawMessage, error) {
+	topic, err := s.topic()
+	if err != nil {
+		return nil, err
+	}
+
+	processed := false
+
+	messages, err := s.messages.GetMessages(store.GetRawMessagesInput{
+		Topic:     topic.ID,
+		StartID:   int(start),
+		Limit:     limit,
+		Processed: &processed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.RawMessage, len(messages))
+	for idx := range messages {
+		container, err := hexMessage(&messages[idx])
+		if err != nil {
+			return nil, err
+		}
+		result[idx] = container
+	}
+
+	return result, nil
+}
+
+// Ack marks the raw message processed. Messages are indexed by their ID, so containers
+// rebuilt from failed container records are acked by the index ID as well.
+func (s MessagesSource) Ack(db *store.DB, container *model.RawMessage) error {
+	return db.RawMessages.MarkMessageProcessed(&model.RawMessage{ID: container.IndexID})
+}
+
+func (s MessagesSource) topic() (*model.RawMessageTopic, error) {
+	topic, err := s.messages.GetTopicByChain(s.chain)
+	if err != nil {
+		return nil, err
+	}
+	if topic.ID == 0 {
+		return nil, fmt.Errorf("raw messages topic for chain %s does not exist", s.chain)
+	}
+	return topic, nil
+}
+
+// hexMessage converts the base64 payload of the raw message into the hex encoding
+// used by the index API, so all sources share the same container handlers.
+func hexMessage(msg *model.RawMessage) (*model.RawMessage, error) {
+	data, err := msg.DataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := formatting.EncodeWithChecksum(formatting.Hex, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RawMessage{
+		ID:        msg.ID,
+		TopicID:   msg.TopicID,
+		IndexID:   msg.ID,
+		Data:      encoded,
+		Hash:      msg.Hash,
+		CreatedAt: msg.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package shared
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+// stubMessages serves raw messages of a single topic from memory
+type stubMessages struct {
+	topic    model.RawMessageTopic
+	messages []model.RawMessage
+	input    store.GetRawMessagesInput
+}
+
+func (s *stubMessages) GetTopicByChain(chain string) (*model.RawMessageTopic, error) {
+	if chain != s.topic.Chain {
+		return &model.RawMessageTopic{}, nil
+	}
+	return &s.topic, nil
+}
+
+func (s *stubMessages) GetMessages(input store.GetRawMessagesInput) ([]model.RawMessage, error) {
+	s.input = input
+	return s.messages, nil
+}
+
+func (s *stubMessages) LastMessage(topic int) (*model.RawMessage, error) {
+	if len(s.messages) == 0 {
+		return nil, store.ErrNotFound
+	}
+	return &s.messages[len(s.messages)-1], nil
+}
+
+func TestMessagesSource(t *testing.T) {
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	payload := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	messages := &stubMessages{
+		topic: model.RawMessageTopic{ID: 3, Chain: "X"},
+		messages: []model.RawMessage{
+			{ID: 41, TopicID: 3, Data: base64.StdEncoding.EncodeToString(payload), Hash: "a", CreatedAt: ts},
+			{ID: 42, TopicID: 3, Data: base64.RawStdEncoding.EncodeToString(payload[:3]), Hash: "b", CreatedAt: ts.Add(time.Second)},
+		},
+	}
+	source := MessagesSource{messages: messages, chain: "X"}
+
+	assert.Equal(t, "X_ipc", source.StatusKey())
+
+	t.Run("tip", func(t *testing.T) {
+		id, tipTime, err := source.Tip()
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), id)
+		assert.Equal(t, ts.Add(time.Second), tipTime)
+	})
+
+	t.Run("empty topic tip", func(t *testing.T) {
+		source := MessagesSource{messages: &stubMessages{topic: messages.topic}, chain: "X"}
+
+		id, tipTime, err := source.Tip()
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), id)
+		assert.True(t, tipTime.IsZero())
+	})
+
+	t.Run("range", func(t *testing.T) {
+		containers, err := source.Range(41, 100)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, messages.input.Topic)
+		assert.Equal(t, 41, messages.input.StartID)
+		assert.Equal(t, 100, messages.input.Limit)
+		require.NotNil(t, messages.input.Processed)
+		assert.False(t, *messages.input.Processed)
+
+		require.Len(t, containers, 2)
+		for i, data := range [][]byte{payload, payload[:3]} {
+			expected, err := formatting.EncodeWithChecksum(formatting.Hex, data)
+			require.NoError(t, err)
+
+			// Messages are indexed by their ID and hex encoded like index API containers
+			assert.Equal(t, messages.messages[i].ID, containers[i].ID)
+			assert.Equal(t, messages.messages[i].ID, containers[i].IndexID)
+			assert.Equal(t, expected, containers[i].Data)
+			assert.Equal(t, messages.messages[i].Hash, containers[i].Hash)
+			assert.Equal(t, messages.messages[i].CreatedAt, containers[i].CreatedAt)
+		}
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		source := MessagesSource{
+			messages: &stubMessages{
+				topic:    messages.topic,
+				messages: []model.RawMessage{{ID: 1, Data: "not base64!"}},
+			},
+			chain: "X",
+		}
+
+		_, err := source.Range(1, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing topic", func(t *testing.T) {
+		source := MessagesSource{messages: messages, chain: "P"}
+
+		_, _, err := source.Tip()
+		assert.EqualError(t, err, "raw messages topic for chain P does not exist")
+
+		_, err = source.Range(1, 100)
+		assert.EqualError(t, err, "raw messages topic for chain P does not exist")
+	})
+}
@@ -1,36 +1,32 @@
 package shared
 
 import (
-	"strconv"
+	"fmt"
 
-	"github.com/figment-networks/avalanche-indexer/client"
 	"github.com/figment-networks/avalanche-indexer/model"
 	"github.com/figment-networks/avalanche-indexer/store"
-	"github.com/figment-networks/avalanche-indexer/util"
 )
 
-func GetSyncStatus(indexClient *client.IndexClient, db *store.DB, containerType string, chain string) (*model.SyncStatus, error) {
-	lastAccepted, err := indexClient.GetLastAccepted(containerType)
-	if err != nil {
-		return nil, err
-	}
+// HandlerFunc processes a single container using the given transaction-scoped store
+type HandlerFunc func(*store.DB, *model.RawMessage) error
 
-	lastIndex, err := util.ParseInt64(lastAccepted.Index)
+func GetSyncStatus(source ContainerSource, db *store.DB) (*model.SyncStatus, error) {
+	tipID, tipTime, err := source.Tip()
 	if err != nil {
 		return nil, err
 	}
 
-	status, err := db.Platform.GetSyncStatus(chain)
+	status, err := db.Platform.GetSyncStatus(source.StatusKey())
 	if err != nil {
 		if err != store.ErrNotFound {
 			return nil, err
 		}
 
 		status = &model.SyncStatus{
-			ID:      chain,
+			ID:      source.StatusKey(),
 			IndexID: 0,
-			TipID:   lastIndex,
-			TipTime: lastAccepted.Timestamp,
+			TipID:   tipID,
+			TipTime: tipTime,
 		}
 
 		if err := db.Platform.UpdateSyncStatus(status); err != nil {
@@ -38,45 +34,82 @@ func GetSyncStatus(indexClient *client.IndexClient, db *store.DB, containerType
 		}
 	}
 
-	status.TipID = lastIndex
-	status.TipTime = lastAccepted.Timestamp
+	status.TipID = tipID
+	status.TipTime = tipTime
 
 	return status, nil
 }
 
 func ProcessContainerRange(
 	status *model.SyncStatus,
-	indexClient *client.IndexClient,
+	source ContainerSource,
 	db *store.DB,
-	containerType string,
 	batchSize int,
-	handlerFn func(*model.RawMessage) error,
+	policy FailurePolicy,
+	handlerFn HandlerFunc,
 ) error {
-	resp, err := indexClient.GetContainerRange(containerType, int(status.NextID()), batchSize)
+	containers, err := source.Range(status.NextID(), batchSize)
 	if err != nil {
 		return err
 	}
-	containerItems := resp.Containers
 
-	for _, c := range containerItems {
-		idx, err := strconv.Atoi(c.Index)
+	for _, container := range containers {
+		next := *status
+		next.IndexID = int64(container.IndexID)
+		next.IndexTime = container.CreatedAt
+
+		// Container writes and the sync status are committed together so that
+		// a restart never reprocesses or skips a container.
+		err = db.Transaction(func(tx *store.DB) error {
+			if err := handlerFn(tx, container); err != nil {
+				return err
+			}
+			if err := source.Ack(tx, container); err != nil {
+				return err
+			}
+			return tx.Platform.UpdateSyncStatus(&next)
+		})
+
 		if err != nil {
-			return err
+			if err := skipContainer(db, source, container, &next, policy, err); err != nil {
+				return err
+			}
 		}
 
-		raw := &model.RawMessage{
-			IndexID:   idx,
-			CreatedAt: c.Timestamp,
-			Data:      c.Bytes,
-		}
+		*status = next
+	}
+
+	return db.Platform.UpdateSyncStatus(status)
+}
 
-		if err := handlerFn(raw); err != nil {
+// skipContainer records the failed container and, when the policy allows it, moves the
+// sync status past it. The failure record, ack and status are committed together.
+func skipContainer(
+	db *store.DB,
+	source ContainerSource,
+	container *model.RawMessage,
+	next *model.SyncStatus,
+	policy FailurePolicy,
+	failure error,
+) error {
+	skip := false
+
+	err := db.Transaction(func(tx *store.DB) error {
+		var err error
+		if skip, err = handleFailure(tx, next.ID, container, policy, failure); err != nil || !skip {
 			return err
 		}
-
-		status.IndexID = int64(idx)
-		status.IndexTime = c.Timestamp
+		if err := source.Ack(tx, container); err != nil {
+			return err
+		}
+		return tx.Platform.UpdateSyncStatus(next)
+	})
+	if err != nil {
+		return err
+	}
+	if !skip {
+		return fmt.Errorf("container %d processing failed: %w", container.IndexID, failure)
 	}
 
-	return db.Platform.UpdateSyncStatus(status)
+	return nil
 }
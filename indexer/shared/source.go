@@ -0,0 +1,177 @@
+package shared
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/figment-networks/avalanche-indexer/archive"
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store"
+	"github.com/figment-networks/avalanche-indexer/util"
+)
+
+const (
+	// SourceIndex makes the chain workers poll containers from the node index API
+	SourceIndex = "index"
+
+	// SourceIPC makes the chain workers consume raw messages written by the ingest command
+	SourceIPC = "ipc"
+
+	// SourceArchive makes the chain workers read containers from a local archive directory
+	SourceArchive = "archive"
+)
+
+// ContainerSource provides accepted chain containers to the chain workers.
+// Container data is hex encoded, the same way the index API returns it.
+type ContainerSource interface {
+	// StatusKey returns the sync status ID used to track the source progress
+	StatusKey() string
+
+	// Tip returns the index and time of the last available container
+	Tip() (int64, time.Time, error)
+
+	// Range returns up to limit containers starting at the given index
+	Range(start int64, limit int) ([]*model.RawMessage, error)
+
+	// Ack is called within the container transaction once it's processed
+	Ack(db *store.DB, container *model.RawMessage) error
+}
+
+// NewContainerSource returns the container source of given kind for the chain
+func NewContainerSource(kind string, rpc *client.Client, db *store.DB, containerType string, chain string, archiveDir string) (ContainerSource, error) {
+	switch kind {
+	case SourceIndex:
+		return NewIndexSource(&rpc.Index, containerType, chain), nil
+	case SourceIPC:
+		return NewMessagesSource(db, chain), nil
+	case SourceArchive:
+		return NewArchiveSource(filepath.Join(archiveDir, chain), chain)
+	default:
+		return nil, fmt.Errorf("invalid container source: %q", kind)
+	}
+}
+
+// indexAPI is the part of the node index API used by the index source
+type indexAPI interface {
+	GetLastAccepted(chain string) (*client.Container, error)
+	GetContainerRange(chain string, startIndex int, numToFetch int) (*client.ContainersResponse, error)
+}
+
+// IndexSource fetches containers from the node index API
+type IndexSource struct {
+	client        indexAPI
+	containerType string
+	chain         string
+}
+
+func NewIndexSource(indexClient *client.IndexClient, containerType string, chain string) IndexSource {
+	return IndexSource{
+		client:        indexClient,
+		containerType: containerType,
+		chain:         chain,
+	}
+}
+
+func (s IndexSource) StatusKey() string {
+	return s.chain
+}
+
+func (s IndexSource) Tip() (int64, time.Time, error) {
+	lastAccepted, err := s.client.GetLastAccepted(s.containerType)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	lastIndex, err := util.ParseInt64(lastAccepted.Index)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return lastIndex, lastAccepted.Timestamp, nil
+}
+
+func (s IndexSource) Range(start int64, limit int) ([]*model.RawMessage, error) {
+	resp, err := s.client.GetContainerRange(s.containerType, int(start), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.RawMessage, len(resp.Containers))
+	for i, c := range resp.Containers {
+		idx, err := strconv.Atoi(c.Index)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = &model.RawMessage{
+			IndexID:   idx,
+			CreatedAt: c.Timestamp,
+			Data:      c.Bytes,
+		}
+	}
+
+	return result, nil
+}
+
+func (s IndexSource) Ack(db *store.DB, container *model.RawMessage) error {
+	return nil
+}
+
+// ArchiveSource reads containers from a local chain archive directory
+type ArchiveSource struct {
+	reader *archive.Reader
+	chain  string
+}
+
+func NewArchiveSource(dir string, chain string) (*ArchiveSource, error) {
+	reader, err := archive.NewReader(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest := reader.Manifest(); manifest.Chain != chain {
+		return nil, fmt.Errorf("archive chain mismatch: expected %s, got %s", chain, manifest.Chain)
+	}
+
+	return &ArchiveSource{
+		reader: reader,
+		chain:  chain,
+	}, nil
+}
+
+func (s *ArchiveSource) StatusKey() string {
+	return s.chain
+}
+
+func (s *ArchiveSource) Tip() (int64, time.Time, error) {
+	manifest := s.reader.Manifest()
+	return manifest.EndIndex, manifest.EndTime, nil
+}
+
+func (s *ArchiveSource) Range(start int64, limit int) ([]*model.RawMessage, error) {
+	containers, err := s.reader.Containers(start, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) > 0 && containers[0].Index != start {
+		return nil, fmt.Errorf("archive is missing container %d", start)
+	}
+
+	result := make([]*model.RawMessage, len(containers))
+	for idx, c := range containers {
+		result[idx] = &model.RawMessage{
+			IndexID:   int(c.Index),
+			CreatedAt: c.Timestamp,
+			Data:      c.Data,
+		}
+	}
+
+	return result, nil
+}
+
+func (s *ArchiveSource) Ack(db *store.DB, container *model.RawMessage) error {
+	return nil
+}
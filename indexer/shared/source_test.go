@@ -0,0 +1,169 @@
+package shared
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/figment-networks/avalanche-indexer/archive"
+	"github.com/figment-networks/avalanche-indexer/client"
+	"github.com/figment-networks/avalanche-indexer/store"
+)
+
+// stubIndex serves index API containers from memory and records the requested ranges
+type stubIndex struct {
+	containers []client.Container
+	err        error
+
+	chain string
+	start int
+	limit int
+}
+
+func (s *stubIndex) GetLastAccepted(chain string) (*client.Container, error) {
+	s.chain = chain
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &s.containers[len(s.containers)-1], nil
+}
+
+func (s *stubIndex) GetContainerRange(chain string, startIndex int, numToFetch int) (*client.ContainersResponse, error) {
+	s.chain, s.start, s.limit = chain, startIndex, numToFetch
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &client.ContainersResponse{Containers: s.containers}, nil
+}
+
+func TestIndexSource(t *testing.T) {
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	index := &stubIndex{
+		containers: []client.Container{
+			{Index: "10", Bytes: "0x0a", Timestamp: ts},
+			{Index: "11", Bytes: "0x0b", Timestamp: ts.Add(time.Second)},
+		},
+	}
+	source := IndexSource{client: index, containerType: "X", chain: "X"}
+
+	assert.Equal(t, "X", source.StatusKey())
+
+	t.Run("tip", func(t *testing.T) {
+		id, tipTime, err := source.Tip()
+		require.NoError(t, err)
+		assert.Equal(t, "X", index.chain)
+		assert.Equal(t, int64(11), id)
+		assert.Equal(t, ts.Add(time.Second), tipTime)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		containers, err := source.Range(10, 256)
+		require.NoError(t, err)
+		assert.Equal(t, 10, index.start)
+		assert.Equal(t, 256, index.limit)
+
+		require.Len(t, containers, 2)
+		assert.Equal(t, 10, containers[0].IndexID)
+		assert.Equal(t, "0x0a", containers[0].Data)
+		assert.Equal(t, ts, containers[0].CreatedAt)
+		assert.Equal(t, 11, containers[1].IndexID)
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		source := IndexSource{client: &stubIndex{containers: []client.Container{{Index: "n/a"}}}, containerType: "X", chain: "X"}
+
+		_, _, err := source.Tip()
+		assert.Error(t, err)
+
+		_, err = source.Range(0, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		source := IndexSource{client: &stubIndex{err: errors.New("unavailable")}, containerType: "X", chain: "X"}
+
+		_, _, err := source.Tip()
+		assert.EqualError(t, err, "unavailable")
+
+		_, err = source.Range(0, 1)
+		assert.EqualError(t, err, "unavailable")
+	})
+}
+
+func TestArchiveSource(t *testing.T) {
+	dir := t.TempDir()
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	writer, err := archive.NewWriter(dir, "P", archive.FormatJSONL, 2)
+	require.NoError(t, err)
+	for idx := int64(3); idx <= 7; idx++ {
+		require.NoError(t, writer.Write(archive.Container{
+			Index:     idx,
+			Timestamp: ts.Add(time.Duration(idx) * time.Second),
+			Data:      "0x00",
+		}))
+	}
+	require.NoError(t, writer.Close())
+
+	_, err = NewArchiveSource(dir, "X")
+	assert.EqualError(t, err, "archive chain mismatch: expected X, got P")
+
+	source, err := NewArchiveSource(dir, "P")
+	require.NoError(t, err)
+	assert.Equal(t, "P", source.StatusKey())
+
+	t.Run("tip", func(t *testing.T) {
+		id, tipTime, err := source.Tip()
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), id)
+		assert.True(t, ts.Add(7*time.Second).Equal(tipTime))
+	})
+
+	t.Run("range", func(t *testing.T) {
+		containers, err := source.Range(4, 3)
+		require.NoError(t, err)
+		require.Len(t, containers, 3)
+
+		for i, container := range containers {
+			assert.Equal(t, 4+i, container.IndexID)
+			assert.Equal(t, "0x00", container.Data)
+			assert.True(t, ts.Add(time.Duration(4+i)*time.Second).Equal(container.CreatedAt))
+		}
+	})
+
+	t.Run("range past tip", func(t *testing.T) {
+		containers, err := source.Range(8, 10)
+		require.NoError(t, err)
+		assert.Empty(t, containers)
+	})
+
+	t.Run("missing containers", func(t *testing.T) {
+		_, err := source.Range(1, 10)
+		assert.EqualError(t, err, "archive is missing container 1")
+	})
+}
+
+func TestNewContainerSource(t *testing.T) {
+	rpc := client.New("http://localhost:9650")
+	db := &store.DB{}
+
+	source, err := NewContainerSource(SourceIndex, rpc, db, "X", "X", "")
+	require.NoError(t, err)
+	assert.IsType(t, IndexSource{}, source)
+	assert.Equal(t, "X", source.StatusKey())
+
+	source, err = NewContainerSource(SourceIPC, rpc, db, "X", "X", "")
+	require.NoError(t, err)
+	assert.IsType(t, MessagesSource{}, source)
+	assert.Equal(t, "X_ipc", source.StatusKey())
+
+	_, err = NewContainerSource(SourceArchive, rpc, db, "X", "X", t.TempDir())
+	assert.Error(t, err)
+
+	_, err = NewContainerSource("kafka", rpc, db, "X", "X", "")
+	assert.EqualError(t, err, `invalid container source: "kafka"`)
+}
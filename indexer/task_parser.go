@@ -30,6 +30,7 @@ func (t *ParserTask) Run(ctx context.Context, p pipeline.Payload) error {
 		t.prepareValidatorShares,
 		t.prepareValidators,
 		t.prepareDelegations,
+		t.preparePendingStakers,
 		t.parseMinStake,
 		t.parseTxFee,
 		t.prepareNetworkMetric,
@@ -231,3 +232,69 @@ func (t ParserTask) prepareDelegations(payload *Payload) error {
 
 	return nil
 }
+
+// preparePendingStakers builds pending validator and delegation records
+func (t ParserTask) preparePendingStakers(payload *Payload) error {
+	delegationsCount := map[string]int{}
+	delegatedAmount := map[string]types.Amount{}
+	delegationFees := map[string]float64{}
+
+	for _, d := range payload.PendingDelegators {
+		if _, ok := delegatedAmount[d.NodeID]; !ok {
+			delegatedAmount[d.NodeID] = types.NewInt64Amount(0)
+		}
+		delegationsCount[d.NodeID]++
+		delegatedAmount[d.NodeID] = delegatedAmount[d.NodeID].Add(types.NewAmount(d.StakeAmount))
+	}
+
+	for _, validator := range payload.CurrentValidators {
+		fee, err := util.ParseFloat32(validator.DelegationFee)
+		if err != nil {
+			return err
+		}
+		delegationFees[validator.NodeID] = fee
+	}
+
+	for _, validator := range payload.PendingValidators {
+		record, err := initPendingValidator(&validator, payload.SyncTime)
+		if err != nil {
+			return err
+		}
+
+		if amount, ok := delegatedAmount[validator.NodeID]; ok {
+			record.DelegatedAmount = amount
+		}
+		record.DelegationsCount = delegationsCount[validator.NodeID]
+		record.Capacity = record.StakeAmount.Mul(types.NewInt64Amount(4)).Sub(record.DelegatedAmount)
+		record.FirstHeight = payload.Height
+		record.LastHeight = payload.Height
+
+		// Delegations to a node that is not validating yet use the fee of the pending validator
+		if _, ok := delegationFees[validator.NodeID]; !ok {
+			delegationFees[validator.NodeID] = record.DelegationFee
+		}
+
+		payload.PendingValidatorRecords = append(payload.PendingValidatorRecords, *record)
+	}
+
+	for _, d := range payload.PendingDelegators {
+		delegation, err := initDelegation(&d)
+		if err != nil {
+			return err
+		}
+
+		if fee, ok := delegationFees[d.NodeID]; ok {
+			delegation.DelegationFee = &fee
+		}
+		delegation.Active = false
+		delegation.Status = model.DelegationStatusPending
+		delegation.FirstHeight = payload.Height
+		delegation.LastHeight = payload.Height
+		delegation.CreatedAt = payload.SyncTime
+		delegation.UpdatedAt = payload.SyncTime
+
+		payload.PendingDelegations = append(payload.PendingDelegations, delegation)
+	}
+
+	return nil
+}
@@ -93,12 +93,23 @@ func (t PersistorTask) createValidators(payload *Payload) error {
 		return err
 	}
 
-	return nil
+	currentTxIDs := make([]string, len(payload.CurrentValidators))
+	for idx, validator := range payload.CurrentValidators {
+		currentTxIDs[idx] = validator.TxID
+	}
+
+	t.logger.Debug("creating pending validators")
+	return t.db.Validators.ImportPending(payload.PendingValidatorRecords, currentTxIDs, payload.SyncTime)
 }
 
 func (t PersistorTask) createDelegations(payload *Payload) error {
 	t.logger.Debug("creating delegations")
-	return t.db.Delegators.Import(payload.Delegations, store.DelegationsBatchSize)
+
+	records := make([]model.Delegation, 0, len(payload.PendingDelegations)+len(payload.Delegations))
+	records = append(records, payload.PendingDelegations...)
+	records = append(records, payload.Delegations...)
+
+	return t.db.Delegators.Import(records, store.DelegationsBatchSize, payload.SyncTime)
 }
 
 func (t PersistorTask) createNetworkRecords(payload *Payload) error {
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+)
+
+// ContractAbi is an ABI uploaded for a C-chain contract
+type ContractAbi struct {
+	Address   string    `json:"address"`
+	Name      *string   `json:"name"`
+	Abi       string    `json:"abi"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (ContractAbi) TableName() string {
+	return "contract_abis"
+}
+
+// DecodedArg is a single named argument of a decoded call or event
+type DecodedArg struct {
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	Indexed bool        `json:"indexed,omitempty"`
+	Value   interface{} `json:"value"`
+}
+
+// DecodedCall is a contract method call decoded from the input data
+type DecodedCall struct {
+	Name      string       `json:"name"`
+	Signature string       `json:"signature"`
+	Args      []DecodedArg `json:"args"`
+}
+
+// DecodedEvent is a contract event decoded from the log topics and data
+type DecodedEvent struct {
+	Name      string       `json:"name"`
+	Signature string       `json:"signature"`
+	Args      []DecodedArg `json:"args"`
+}
@@ -11,6 +11,8 @@ type Block struct {
 	Chain     string    `json:"chain"`
 	Height    uint64    `json:"height"`
 	Timestamp time.Time `json:"timestamp"`
+
+	Evm *EvmBlock `json:"evm,omitempty" gorm:"-"`
 }
 
 func (Block) TableName() string {
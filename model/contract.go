@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+)
+
+const (
+	// Proxy implementation storage slot standards
+	ProxyTypeEIP1967 = "eip1967"
+	ProxyTypeEIP1822 = "eip1822"
+)
+
+type Contract struct {
+	Address        string    `json:"address"`
+	Creator        string    `json:"creator"`
+	CreationTx     string    `json:"creation_tx"`
+	BlockHeight    uint64    `json:"block_height"`
+	BytecodeHash   *string   `json:"bytecode_hash"`
+	BytecodeSize   int       `json:"bytecode_size"`
+	ProxyType      *string   `json:"proxy_type"`
+	Implementation *string   `json:"implementation"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (Contract) TableName() string {
+	return "contracts"
+}
+
+// ContractImplementation is a proxy implementation change
+type ContractImplementation struct {
+	Contract       string    `json:"contract"`
+	Implementation string    `json:"implementation"`
+	ProxyType      string    `json:"proxy_type"`
+	BlockHeight    uint64    `json:"block_height"`
+	TxHash         string    `json:"tx_hash"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+func (ContractImplementation) TableName() string {
+	return "contract_implementations"
+}
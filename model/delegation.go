@@ -16,6 +16,12 @@ type Delegation struct {
 	Active          bool         `json:"active"`
 	ActiveStartTime time.Time    `json:"active_start_time"`
 	ActiveEndTime   time.Time    `json:"active_end_time"`
+	Status          string       `json:"status"`
+	DelegationFee   *float64     `json:"delegation_fee"`
+	Rewarded        *bool        `json:"rewarded"`
+	RewardAmount    types.Amount `json:"reward_amount"`
+	RewardTxID      *string      `json:"reward_tx_id"`
+	EndedAt         *time.Time   `json:"ended_at"`
 	FirstHeight     int64        `json:"first_height"`
 	LastHeight      int64        `json:"last_height"`
 	CreatedAt       time.Time    `json:"created_at"`
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+const (
+	// DEX liquidity event types
+	DexLiquidityMint = "mint"
+	DexLiquidityBurn = "burn"
+)
+
+// DexPair is a Uniswap-V2-style liquidity pool
+type DexPair struct {
+	Address   string    `json:"address"`
+	Factory   *string   `json:"factory"`
+	Token0    string    `json:"token0"`
+	Token1    string    `json:"token1"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (DexPair) TableName() string {
+	return "dex_pairs"
+}
+
+// DexSwap is a swap executed on a pair, reserves are taken from the preceding Sync event
+type DexSwap struct {
+	TxHash      string       `json:"tx_hash"`
+	LogIndex    int          `json:"log_index"`
+	BlockHeight uint64       `json:"block_height"`
+	Pair        string       `json:"pair"`
+	Token0      string       `json:"token0"`
+	Token1      string       `json:"token1"`
+	Origin      string       `json:"origin"`
+	Sender      string       `json:"sender"`
+	Recipient   string       `json:"recipient"`
+	Amount0In   types.Amount `json:"amount0_in"`
+	Amount1In   types.Amount `json:"amount1_in"`
+	Amount0Out  types.Amount `json:"amount0_out"`
+	Amount1Out  types.Amount `json:"amount1_out"`
+	Reserve0    types.Amount `json:"reserve0"`
+	Reserve1    types.Amount `json:"reserve1"`
+	Timestamp   time.Time    `json:"timestamp"`
+}
+
+func (DexSwap) TableName() string {
+	return "dex_swaps"
+}
+
+// DexLiquidityEvent is a liquidity deposit (mint) or withdrawal (burn) on a pair
+type DexLiquidityEvent struct {
+	TxHash      string       `json:"tx_hash"`
+	LogIndex    int          `json:"log_index"`
+	BlockHeight uint64       `json:"block_height"`
+	Type        string       `json:"type"`
+	Pair        string       `json:"pair"`
+	Token0      string       `json:"token0"`
+	Token1      string       `json:"token1"`
+	Origin      string       `json:"origin"`
+	Sender      string       `json:"sender"`
+	Recipient   *string      `json:"recipient"`
+	Amount0     types.Amount `json:"amount0"`
+	Amount1     types.Amount `json:"amount1"`
+	Reserve0    types.Amount `json:"reserve0"`
+	Reserve1    types.Amount `json:"reserve1"`
+	Timestamp   time.Time    `json:"timestamp"`
+}
+
+func (DexLiquidityEvent) TableName() string {
+	return "dex_liquidity_events"
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/lib/pq"
+
+	"github.com/figment-networks/avalanche-indexer/model/types"
 )
 
 type EvmTrace struct {
@@ -35,4 +37,129 @@ type EvmLog struct {
 	Removed bool           `json:"removed"`
 	Topics  pq.StringArray `gorm:"type:text[]" json:"topics"`
 	Data    string         `json:"data"`
+
+	Decoded *DecodedEvent `json:"decoded,omitempty" gorm:"-"`
+}
+
+// EvmLogRecord is a single receipt log entry stored in the evm logs table
+type EvmLogRecord struct {
+	TxHash      string    `json:"tx_hash"`
+	BlockHeight uint64    `json:"block_height"`
+	LogIndex    int       `json:"log_index"`
+	TxIndex     int       `json:"tx_index"`
+	Address     string    `json:"address"`
+	Topic0      *string   `json:"topic0"`
+	Topic1      *string   `json:"topic1"`
+	Topic2      *string   `json:"topic2"`
+	Topic3      *string   `json:"topic3"`
+	Data        string    `json:"data"`
+	Removed     bool      `json:"removed"`
+	Timestamp   time.Time `json:"timestamp"`
+
+	Decoded *DecodedEvent `json:"decoded,omitempty" gorm:"-"`
+}
+
+func (EvmLogRecord) TableName() string {
+	return "evm_logs"
+}
+
+// EvmInternalTx is a single nested call of the transaction trace
+type EvmInternalTx struct {
+	TxHash      string        `json:"tx_hash"`
+	TraceIndex  int           `json:"trace_index"`
+	TracePath   pq.Int64Array `json:"trace_path" gorm:"type:integer[]"`
+	BlockHeight uint64        `json:"block_height"`
+	Type        string        `json:"type"`
+	From        string        `json:"from" gorm:"column:from_address"`
+	To          string        `json:"to" gorm:"column:to_address"`
+	Value       types.Amount  `json:"value"`
+	Gas         uint64        `json:"gas"`
+	GasUsed     uint64        `json:"gas_used"`
+	Revert      bool          `json:"revert"`
+	Error       *string       `json:"error"`
+	Timestamp   time.Time     `json:"timestamp"`
+}
+
+func (EvmInternalTx) TableName() string {
+	return "evm_internal_txs"
+}
+
+// EvmBlock holds the C-chain block header details
+type EvmBlock struct {
+	Hash           string       `json:"hash"`
+	Chain          string       `json:"chain"`
+	Height         uint64       `json:"height"`
+	ParentHash     string       `json:"parent_hash"`
+	Coinbase       string       `json:"coinbase"`
+	StateRoot      string       `json:"state_root"`
+	TxRoot         string       `json:"tx_root"`
+	ReceiptsRoot   string       `json:"receipts_root"`
+	ExtDataHash    string       `json:"ext_data_hash"`
+	Difficulty     types.Amount `json:"difficulty"`
+	GasLimit       uint64       `json:"gas_limit"`
+	GasUsed        uint64       `json:"gas_used"`
+	BaseFee        types.Amount `json:"base_fee"`
+	ExtDataGasUsed types.Amount `json:"ext_data_gas_used"`
+	BlockGasCost   types.Amount `json:"block_gas_cost"`
+	ExtraData      string       `json:"extra_data"`
+	ExtDataSize    int          `json:"ext_data_size"`
+	TxCount        int          `json:"tx_count"`
+	Size           uint64       `json:"size"`
+	Timestamp      time.Time    `json:"timestamp"`
+}
+
+func (EvmBlock) TableName() string {
+	return "evm_blocks"
+}
+
+// EvmTransaction holds the C-chain transaction payload
+type EvmTransaction struct {
+	Hash                 string       `json:"hash"`
+	BlockHash            string       `json:"block_hash"`
+	BlockHeight          uint64       `json:"block_height"`
+	TxIndex              int          `json:"tx_index"`
+	Type                 int          `json:"type"`
+	From                 string       `json:"from" gorm:"column:from_address"`
+	To                   *string      `json:"to" gorm:"column:to_address"`
+	Nonce                uint64       `json:"nonce"`
+	Value                types.Amount `json:"value"`
+	Gas                  uint64       `json:"gas"`
+	GasPrice             types.Amount `json:"gas_price"`
+	MaxFeePerGas         types.Amount `json:"max_fee_per_gas"`
+	MaxPriorityFeePerGas types.Amount `json:"max_priority_fee_per_gas"`
+	Input                string       `json:"input"`
+	V                    string       `json:"v"`
+	R                    string       `json:"r"`
+	S                    string       `json:"s"`
+	ContractCreation     bool         `json:"contract_creation"`
+	ContractAddress      *string      `json:"contract_address"`
+	Timestamp            time.Time    `json:"timestamp"`
+}
+
+func (EvmTransaction) TableName() string {
+	return "evm_transactions"
+}
+
+// EvmBalanceChange is a single change of the native AVAX balance of a C-chain address in wei.
+// Amounts are negative for debits.
+type EvmBalanceChange struct {
+	TxHash       string       `json:"tx_hash"`
+	ChangeIndex  int          `json:"change_index"`
+	BlockHeight  uint64       `json:"block_height"`
+	TxIndex      int          `json:"tx_index"`
+	Address      string       `json:"address"`
+	Type         string       `json:"type"`
+	Amount       types.Amount `json:"amount"`
+	Counterparty *string      `json:"counterparty"`
+	Timestamp    time.Time    `json:"timestamp"`
+}
+
+func (EvmBalanceChange) TableName() string {
+	return "evm_balance_changes"
+}
+
+// EvmBalanceHistoryItem is a balance change with the address balance after it
+type EvmBalanceHistoryItem struct {
+	EvmBalanceChange
+	Balance types.Amount `json:"balance"`
 }
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type FailedContainer struct {
+	ID         int        `json:"id"`
+	Chain      string     `json:"chain"`
+	IndexID    int64      `json:"index_id"`
+	Data       string     `json:"data"`
+	Error      string     `json:"error"`
+	Status     string     `json:"status"`
+	Attempts   int        `json:"attempts"`
+	Timestamp  time.Time  `json:"timestamp"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+func (FailedContainer) TableName() string {
+	return "failed_containers"
+}
+
+// RawMessage returns the container as a message suitable for the chain workers
+func (c FailedContainer) RawMessage() *RawMessage {
+	return &RawMessage{
+		IndexID:   int(c.IndexID),
+		CreatedAt: c.Timestamp,
+		Data:      c.Data,
+	}
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+type NftTransfer struct {
+	TxHash      string       `json:"tx_hash"`
+	BlockHeight uint64       `json:"block_height"`
+	LogIndex    int          `json:"log_index"`
+	BatchIndex  int          `json:"batch_index"`
+	Token       string       `json:"token"`
+	TokenID     types.Amount `json:"token_id"`
+	Operator    *string      `json:"operator"`
+	From        string       `json:"from" gorm:"column:from_address"`
+	To          string       `json:"to" gorm:"column:to_address"`
+	Amount      types.Amount `json:"amount"`
+	Timestamp   time.Time    `json:"timestamp"`
+}
+
+func (NftTransfer) TableName() string {
+	return "nft_transfers"
+}
+
+type NftOwner struct {
+	Token       string       `json:"token"`
+	TokenID     types.Amount `json:"token_id"`
+	Owner       string       `json:"owner"`
+	Amount      types.Amount `json:"amount"`
+	BlockHeight uint64       `json:"block_height"`
+}
+
+func (NftOwner) TableName() string {
+	return "nft_owners"
+}
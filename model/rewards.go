@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
 
 type Reward struct {
 	ID            string    `json:"id"`
@@ -10,6 +14,20 @@ type Reward struct {
 	ProcessedAt   time.Time `json:"processed_at"`
 }
 
+// RewardOutput is a reward UTXO paid out for a staking transaction
+type RewardOutput struct {
+	ID          string         `json:"id"`
+	StakingTxID string         `json:"staking_tx_id"`
+	StakingType string         `json:"staking_type"`
+	RewardTxID  string         `json:"reward_tx_id"`
+	NodeID      string         `json:"node_id"`
+	Asset       string         `json:"asset"`
+	Amount      uint64         `json:"amount"`
+	Addresses   pq.StringArray `json:"addresses" gorm:"type:text[]"`
+	Spent       bool           `json:"spent"`
+	RewardedAt  time.Time      `json:"rewarded_at"`
+}
+
 type RewardsOwner struct {
 	ID        string                `json:"id"`
 	Locktime  uint64                `json:"locktime"`
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// StakingPeriod is a validator or delegator staking lifecycle derived from P-chain transactions
+type StakingPeriod struct {
+	ID             string     `json:"id"`
+	Chain          string     `json:"chain"`
+	Type           string     `json:"type"`
+	NodeID         string     `json:"node_id"`
+	StakeAmount    uint64     `json:"stake_amount"`
+	DelegationFee  *float64   `json:"delegation_fee,omitempty"`
+	RewardAddress  string     `json:"reward_address"`
+	StartTime      time.Time  `json:"start_time"`
+	EndTime        time.Time  `json:"end_time"`
+	AddedHeight    uint64     `json:"added_height"`
+	AddedAt        time.Time  `json:"added_at"`
+	FinishedHeight *uint64    `json:"finished_height,omitempty"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+	RewardTxID     *string    `json:"reward_tx_id,omitempty"`
+	Rewarded       *bool      `json:"rewarded,omitempty"`
+}
+
+func (StakingPeriod) TableName() string {
+	return "staking_periods"
+}
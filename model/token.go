@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/avalanche-indexer/model/types"
+)
+
+type Token struct {
+	Address   string    `json:"address"`
+	Type      string    `json:"type"`
+	Name      *string   `json:"name"`
+	Symbol    *string   `json:"symbol"`
+	Decimals  *int      `json:"decimals"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (Token) TableName() string {
+	return "tokens"
+}
+
+type TokenTransfer struct {
+	TxHash      string       `json:"tx_hash"`
+	LogIndex    int          `json:"log_index"`
+	BlockHeight uint64       `json:"block_height"`
+	Token       string       `json:"token"`
+	From        string       `json:"from" gorm:"column:from_address"`
+	To          string       `json:"to" gorm:"column:to_address"`
+	Amount      types.Amount `json:"amount"`
+	Timestamp   time.Time    `json:"timestamp"`
+}
+
+func (TokenTransfer) TableName() string {
+	return "token_transfers"
+}
+
+type TokenApproval struct {
+	TxHash      string       `json:"tx_hash"`
+	BlockHeight uint64       `json:"block_height"`
+	LogIndex    int          `json:"log_index"`
+	Token       string       `json:"token"`
+	Owner       string       `json:"owner"`
+	Spender     string       `json:"spender"`
+	Amount      types.Amount `json:"amount"`
+	Timestamp   time.Time    `json:"timestamp"`
+}
+
+func (TokenApproval) TableName() string {
+	return "token_approvals"
+}
+
+// TokenAllowance is the outstanding allowance set by the latest approval of the spender
+type TokenAllowance struct {
+	Token       string       `json:"token"`
+	Owner       string       `json:"owner"`
+	Spender     string       `json:"spender"`
+	Amount      types.Amount `json:"amount"`
+	Unlimited   bool         `json:"unlimited" gorm:"-"`
+	BlockHeight uint64       `json:"block_height"`
+	TxHash      string       `json:"tx_hash"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+func (TokenAllowance) TableName() string {
+	return "token_allowances"
+}
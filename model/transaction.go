@@ -32,6 +32,9 @@ type (
 		InputAmounts  map[string]uint64 `json:"input_amounts,omitempty" sql:"-" gorm:"-"`
 		Outputs       []Output          `json:"outputs,omitempty" sql:"-" gorm:"-"`
 		OutputAmounts map[string]uint64 `json:"output_amounts,omitempty" sql:"-" gorm:"-"`
+
+		Evm          *EvmTransaction `json:"evm,omitempty" sql:"-" gorm:"-"`
+		DecodedInput *DecodedCall    `json:"decoded_input,omitempty" sql:"-" gorm:"-"`
 	}
 
 	TransactionTypeCount struct {
@@ -6,6 +6,17 @@ const (
 	AssetTypeVariable = "variable_cap"
 	AssetTypeNFT      = "nft"
 
+	// EVM token types
+	TokenTypeERC20   = "erc20"
+	TokenTypeERC721  = "erc721"
+	TokenTypeERC1155 = "erc1155"
+
+	// EVM balance change types
+	BalanceChangeTransfer = "transfer"
+	BalanceChangeFee      = "fee"
+	BalanceChangeImport   = "import"
+	BalanceChangeExport   = "export"
+
 	// PVM block types
 	BlockTypeProposal = "proposal"
 	BlockTypeStandard = "standard"
@@ -54,6 +65,26 @@ const (
 	RewardTypeValidator = "validator"
 	RewardTypeDelegator = "delegator"
 
+	// Failed container statuses
+	FailedContainerStatusFailed   = "failed"
+	FailedContainerStatusSkipped  = "skipped"
+	FailedContainerStatusRetry    = "retry"
+	FailedContainerStatusResolved = "resolved"
+
+	// Staking period types
+	StakingTypeValidator = "validator"
+	StakingTypeDelegator = "delegator"
+
+	// Validator statuses
+	ValidatorStatusPending = "pending"
+	ValidatorStatusActive  = "active"
+	ValidatorStatusDropped = "dropped"
+
+	// Delegation statuses
+	DelegationStatusPending = "pending"
+	DelegationStatusActive  = "active"
+	DelegationStatusEnded   = "ended"
+
 	// Event scopes
 	EventScopeStaking = "staking"
 	EventScopeRewards = "rewards"
@@ -70,6 +101,9 @@ const (
 	EventTypeDelegatorAdded             = "delegator_added"
 	EventTypeDelegatorFinished          = "delegator_finished"
 	EventTypeSubnetValidatorAdded       = "subnet_validator_added"
+	EventTypeValidatorRewarded          = "validator_rewarded"
+	EventTypeDelegatorRewarded          = "delegator_rewarded"
+	EventTypeRewardForfeited            = "reward_forfeited"
 )
 
 var (
@@ -34,3 +34,26 @@ type Validator struct {
 func (Validator) TableName() string {
 	return "validators"
 }
+
+// PendingValidator is a validator from the pending set that has not started validating yet
+type PendingValidator struct {
+	TxID             string       `json:"tx_id"`
+	NodeID           string       `json:"node_id"`
+	StakeAmount      types.Amount `json:"stake_amount"`
+	RewardAddress    *string      `json:"reward_address"`
+	DelegationFee    float64      `json:"delegation_fee"`
+	DelegationsCount int          `json:"delegations_count"`
+	DelegatedAmount  types.Amount `json:"delegated_amount"`
+	Capacity         types.Amount `json:"capacity"`
+	Status           string       `json:"status"`
+	StartTime        time.Time    `json:"start_time"`
+	EndTime          time.Time    `json:"end_time"`
+	FirstHeight      int64        `json:"first_height"`
+	LastHeight       int64        `json:"last_height"`
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+func (PendingValidator) TableName() string {
+	return "pending_validators"
+}
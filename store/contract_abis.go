@@ -0,0 +1,37 @@
+package store
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+)
+
+type ContractAbisStore struct {
+	*gorm.DB
+}
+
+// Save creates or replaces the contract ABI
+func (s ContractAbisStore) Save(record *model.ContractAbi) error {
+	return s.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "address"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "abi", "updated_at"}),
+		}).
+		Create(record).
+		Error
+}
+
+// Get returns the ABI of the contract
+func (s ContractAbisStore) Get(address string) (*model.ContractAbi, error) {
+	result := &model.ContractAbi{}
+	err := s.Model(result).First(result, "address = ?", address).Error
+	return result, checkErr(err)
+}
+
+// GetAll returns all uploaded ABIs
+func (s ContractAbisStore) GetAll() ([]model.ContractAbi, error) {
+	result := []model.ContractAbi{}
+	err := s.Model(&model.ContractAbi{}).Order("address ASC").Find(&result).Error
+	return result, err
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type ContractsStore struct {
+	*gorm.DB
+}
+
+// Create creates a contract record unless it already exists
+func (s ContractsStore) Create(contract *model.Contract) error {
+	return s.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(contract).
+		Error
+}
+
+// Get returns the contract by address
+func (s ContractsStore) Get(address string) (*model.Contract, error) {
+	result := &model.Contract{}
+	err := s.Model(result).First(result, "address = ?", address).Error
+	return result, checkErr(err)
+}
+
+// AddImplementation records the proxy implementation change and updates the
+// current implementation unless a later change is already known
+func (s ContractsStore) AddImplementation(record *model.ContractImplementation) error {
+	return s.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(record).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(queries.ContractsUpdateImplementation,
+			record.Implementation,
+			record.ProxyType,
+			record.Contract,
+			record.BlockHeight,
+		).Error
+	})
+}
+
+// GetImplementations returns the implementation history of the proxy contract
+func (s ContractsStore) GetImplementations(address string) ([]model.ContractImplementation, error) {
+	result := []model.ContractImplementation{}
+
+	err := s.
+		Model(&model.ContractImplementation{}).
+		Where("contract = ?", address).
+		Order("block_height DESC").
+		Find(&result).
+		Error
+
+	return result, err
+}
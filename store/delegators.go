@@ -1,6 +1,10 @@
 package store
 
 import (
+	"errors"
+	"strings"
+	"time"
+
 	"github.com/figment-networks/avalanche-indexer/model"
 	"github.com/figment-networks/avalanche-indexer/store/queries"
 	"gorm.io/gorm"
@@ -17,15 +21,65 @@ type DelegatorsStore struct {
 type DelegationsSearch struct {
 	NodeID        string `form:"node_id"`
 	RewardAddress string `form:"reward_address"`
+	Status        string `form:"status"`
+	Rewarded      *bool  `form:"rewarded"`
+	StartTime     string `form:"start_time"`
+	EndTime       string `form:"end_time"`
+	At            string `form:"at"`
+	Limit         int    `form:"limit"`
+	Offset        int    `form:"offset"`
+	Page          int    `form:"page"`
+}
+
+func (s *DelegationsSearch) Validate() error {
+	for _, status := range s.statuses() {
+		switch status {
+		case model.DelegationStatusPending, model.DelegationStatusActive, model.DelegationStatusEnded:
+		default:
+			return errors.New("invalid status value")
+		}
+	}
+
+	if _, err := parseTimeFilter(s.StartTime, "bod"); err != nil {
+		return errors.New("invalid start time")
+	}
+	if _, err := parseTimeFilter(s.EndTime, "eod"); err != nil {
+		return errors.New("invalid end time")
+	}
+
+	if s.At != "" {
+		if s.Status != "" || s.Rewarded != nil || s.StartTime != "" || s.EndTime != "" {
+			return errors.New("status and time filters are not supported for historical delegations")
+		}
+		return validateStakingAt(s.At)
+	}
+
+	// Delegations are not paginated unless requested
+	if s.Limit != 0 || s.Offset != 0 || s.Page != 0 {
+		return validatePagination(&s.Limit, &s.Offset, s.Page)
+	}
+	return nil
+}
+
+// statuses returns the requested delegation statuses, active delegations by default
+func (s DelegationsSearch) statuses() []string {
+	if s.Status == "" {
+		return []string{model.DelegationStatusActive}
+	}
+	return strings.Split(s.Status, ",")
 }
 
 // Search performs a seach on delegations
 func (s DelegatorsStore) Search(search DelegationsSearch) ([]model.Delegation, error) {
+	if search.At != "" {
+		return s.searchAt(search)
+	}
+
 	result := []model.Delegation{}
 
 	scope := s.
 		Model(&model.Delegation{}).
-		Where("active = ?", true).
+		Where("status IN (?)", search.statuses()).
 		Order("id DESC")
 
 	if search.NodeID != "" {
@@ -34,17 +88,50 @@ func (s DelegatorsStore) Search(search DelegationsSearch) ([]model.Delegation, e
 	if search.RewardAddress != "" {
 		scope = scope.Where("reward_address = ?", search.RewardAddress)
 	}
+	if search.Rewarded != nil {
+		scope = scope.Where("rewarded = ?", *search.Rewarded)
+	}
+
+	// Time range matches delegations overlapping with the given period
+	if ts, _ := parseTimeFilter(search.StartTime, "bod"); ts != nil {
+		scope = scope.Where("active_end_time >= ?", ts)
+	}
+	if ts, _ := parseTimeFilter(search.EndTime, "eod"); ts != nil {
+		scope = scope.Where("active_start_time <= ?", ts)
+	}
+
+	if search.Limit > 0 {
+		scope = scope.Offset(search.Offset).Limit(search.Limit)
+	}
 
 	err := scope.Find(&result).Error
 	return result, checkErr(err)
 }
 
-// Import imports delegations records in bulk
-func (s DelegatorsStore) Import(records []model.Delegation, batchSize int) error {
-	if err := s.Exec("UPDATE delegations SET active = FALSE").Error; err != nil {
-		return err
+// searchAt returns delegations active at the given time or height derived from staking periods
+func (s DelegatorsStore) searchAt(search DelegationsSearch) ([]model.Delegation, error) {
+	at, err := stakingTime(s.DB, search.At)
+	if err != nil {
+		return nil, err
 	}
 
+	result := []model.Delegation{}
+
+	err = s.
+		Raw(queries.StakingDelegationsAt,
+			at,
+			search.NodeID, search.NodeID,
+			search.RewardAddress, search.RewardAddress,
+		).
+		Scan(&result).
+		Error
+
+	return result, err
+}
+
+// Import imports the pending and current delegations snapshot in bulk and ends
+// delegations that are no longer in either set.
+func (s DelegatorsStore) Import(records []model.Delegation, batchSize int, syncTime time.Time) error {
 	n := len(records)
 
 	for idx := 0; idx < n; idx += batchSize {
@@ -67,6 +154,8 @@ func (s DelegatorsStore) Import(records []model.Delegation, batchSize int) error
 				r.Active,
 				r.ActiveStartTime,
 				r.ActiveEndTime,
+				r.Status,
+				r.DelegationFee,
 				r.FirstHeight,
 				r.LastHeight,
 				r.CreatedAt,
@@ -79,5 +168,19 @@ func (s DelegatorsStore) Import(records []model.Delegation, batchSize int) error
 		}
 	}
 
-	return nil
+	if err := s.Exec(queries.DelegatorsMarkEnded, syncTime).Error; err != nil {
+		return err
+	}
+
+	// Pending delegations are reported without reward owners, those come from the indexed transactions
+	return s.Exec(queries.DelegatorsRewardAddresses).Error
+}
+
+// Finish records the outcome of the delegation once its staking period is over
+func (s DelegatorsStore) Finish(id string, rewardTxID string, endedAt time.Time, rewarded bool, amount uint64) error {
+	// Delegations that started and ended between snapshots are created from the staking period
+	if err := s.Exec(queries.DelegatorsCreateFromPeriod, id).Error; err != nil {
+		return err
+	}
+	return s.Exec(queries.DelegatorsFinish, rewarded, amount, rewardTxID, endedAt, id).Error
 }
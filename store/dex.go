@@ -0,0 +1,217 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type DexStore struct {
+	*gorm.DB
+}
+
+// DexSearch is used for both swap and liquidity event searches, type only applies to the latter
+type DexSearch struct {
+	Pair        string `form:"pair"`
+	Address     string `form:"address"`
+	Type        string `form:"type"`
+	StartHeight int    `form:"start_height"`
+	EndHeight   int    `form:"end_height"`
+	Order       string `form:"order"`
+	Cursor      string `form:"cursor"`
+	Limit       int    `form:"limit"`
+
+	cursor *evmLogCursor
+}
+
+func (input *DexSearch) Validate() error {
+	if input.StartHeight < 0 {
+		return errors.New("invalid start height")
+	}
+	if input.EndHeight < 0 {
+		return errors.New("invalid end height")
+	}
+	if input.EndHeight > 0 && input.EndHeight < input.StartHeight {
+		return errors.New("end height must be greater than start height")
+	}
+
+	switch input.Type {
+	case "", model.DexLiquidityMint, model.DexLiquidityBurn:
+	default:
+		return errors.New("invalid type")
+	}
+
+	switch input.Order {
+	case "":
+		input.Order = "desc"
+	case "asc", "desc":
+	default:
+		return errors.New("invalid order")
+	}
+
+	if input.Cursor != "" {
+		cursor := &evmLogCursor{}
+		if _, err := fmt.Sscanf(input.Cursor, "%d:%d", &cursor.height, &cursor.index); err != nil {
+			return errors.New("invalid cursor value")
+		}
+		input.cursor = cursor
+	}
+
+	if input.Limit < 0 {
+		return errors.New("invalid limit value")
+	}
+	if input.Limit == 0 {
+		input.Limit = 100
+	}
+	if input.Limit > 1000 {
+		return errors.New("limit param max value is 1000")
+	}
+
+	return nil
+}
+
+// NextSwapsCursor returns the cursor for the page following the given swaps
+func (input *DexSearch) NextSwapsCursor(swaps []model.DexSwap) string {
+	if len(swaps) < input.Limit {
+		return ""
+	}
+	last := swaps[len(swaps)-1]
+	return fmt.Sprintf("%d:%d", last.BlockHeight, last.LogIndex)
+}
+
+// NextLiquidityEventsCursor returns the cursor for the page following the given events
+func (input *DexSearch) NextLiquidityEventsCursor(events []model.DexLiquidityEvent) string {
+	if len(events) < input.Limit {
+		return ""
+	}
+	last := events[len(events)-1]
+	return fmt.Sprintf("%d:%d", last.BlockHeight, last.LogIndex)
+}
+
+// scope applies the common search filters
+func (input *DexSearch) scope(scope *gorm.DB) *gorm.DB {
+	if input.Pair != "" {
+		scope = scope.Where("pair = ?", input.Pair)
+	}
+	if input.Address != "" {
+		scope = scope.Where("(origin = ? OR sender = ? OR recipient = ?)", input.Address, input.Address, input.Address)
+	}
+	if input.StartHeight > 0 {
+		scope = scope.Where("block_height >= ?", input.StartHeight)
+	}
+	if input.EndHeight > 0 {
+		scope = scope.Where("block_height <= ?", input.EndHeight)
+	}
+
+	if cursor := input.cursor; cursor != nil {
+		if input.Order == "asc" {
+			scope = scope.Where("(block_height, log_index) > (?, ?)", cursor.height, cursor.index)
+		} else {
+			scope = scope.Where("(block_height, log_index) < (?, ?)", cursor.height, cursor.index)
+		}
+	}
+
+	return scope.
+		Order(fmt.Sprintf("block_height %s, log_index %s", input.Order, input.Order)).
+		Limit(input.Limit)
+}
+
+// CreatePair creates a pair record unless it already exists
+func (s DexStore) CreatePair(pair *model.DexPair) error {
+	return s.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(pair).
+		Error
+}
+
+// GetPair returns the pair by its contract address
+func (s DexStore) GetPair(address string) (*model.DexPair, error) {
+	pair := &model.DexPair{}
+	err := s.Model(pair).First(pair, "address = ?", address).Error
+	return pair, checkErr(err)
+}
+
+// ImportSwaps creates swap records in bulk
+func (s DexStore) ImportSwaps(records []model.DexSwap) error {
+	return bulkImport(s.DB, queries.DexSwapsImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.BlockHeight,
+			r.LogIndex,
+			r.Pair,
+			r.Token0,
+			r.Token1,
+			r.Origin,
+			r.Sender,
+			r.Recipient,
+			r.Amount0In,
+			r.Amount1In,
+			r.Amount0Out,
+			r.Amount1Out,
+			r.Reserve0,
+			r.Reserve1,
+			r.Timestamp,
+		}
+	})
+}
+
+// ImportLiquidityEvents creates liquidity event records in bulk
+func (s DexStore) ImportLiquidityEvents(records []model.DexLiquidityEvent) error {
+	return bulkImport(s.DB, queries.DexLiquidityEventsImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.BlockHeight,
+			r.LogIndex,
+			r.Type,
+			r.Pair,
+			r.Token0,
+			r.Token1,
+			r.Origin,
+			r.Sender,
+			r.Recipient,
+			r.Amount0,
+			r.Amount1,
+			r.Reserve0,
+			r.Reserve1,
+			r.Timestamp,
+		}
+	})
+}
+
+// SearchSwaps returns swaps matching the search input
+func (s DexStore) SearchSwaps(input *DexSearch) ([]model.DexSwap, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := []model.DexSwap{}
+	err := input.scope(s.Model(&model.DexSwap{})).Find(&result).Error
+
+	return result, err
+}
+
+// SearchLiquidityEvents returns liquidity events matching the search input
+func (s DexStore) SearchLiquidityEvents(input *DexSearch) ([]model.DexLiquidityEvent, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.DexLiquidityEvent{})
+	if input.Type != "" {
+		scope = scope.Where("type = ?", input.Type)
+	}
+
+	result := []model.DexLiquidityEvent{}
+	err := input.scope(scope).Find(&result).Error
+
+	return result, err
+}
@@ -0,0 +1,65 @@
+package store
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type EvmStore struct {
+	*gorm.DB
+}
+
+// CreateBlock creates a new C-chain block header record
+func (s EvmStore) CreateBlock(block *model.EvmBlock) error {
+	return s.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(block).
+		Error
+}
+
+// ImportTransactions creates C-chain transaction records in bulk
+func (s EvmStore) ImportTransactions(records []model.EvmTransaction) error {
+	return bulkImport(s.DB, queries.EvmTransactionsImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.Hash,
+			r.BlockHash,
+			r.BlockHeight,
+			r.TxIndex,
+			r.Type,
+			r.From,
+			r.To,
+			r.Nonce,
+			r.Value,
+			r.Gas,
+			r.GasPrice,
+			r.MaxFeePerGas,
+			r.MaxPriorityFeePerGas,
+			r.Input,
+			r.V,
+			r.R,
+			r.S,
+			r.ContractCreation,
+			r.ContractAddress,
+			r.Timestamp,
+		}
+	})
+}
+
+// GetBlock returns the block header record by hash
+func (s EvmStore) GetBlock(hash string) (*model.EvmBlock, error) {
+	result := &model.EvmBlock{}
+	err := s.Model(result).Where("hash = ?", hash).Take(result).Error
+	return result, checkErr(err)
+}
+
+// GetTransaction returns the transaction payload record by hash
+func (s EvmStore) GetTransaction(hash string) (*model.EvmTransaction, error) {
+	result := &model.EvmTransaction{}
+	err := s.Model(result).Where("hash = ?", hash).Take(result).Error
+	return result, checkErr(err)
+}
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"errors"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type EvmBalanceHistorySearch struct {
+	Address     string `form:"address"`
+	StartHeight int    `form:"start_height"`
+	EndHeight   int    `form:"end_height"`
+	Limit       int    `form:"limit"`
+	Offset      int    `form:"offset"`
+	Page        int    `form:"page"`
+}
+
+func (input *EvmBalanceHistorySearch) Validate() error {
+	if input.StartHeight < 0 {
+		return errors.New("invalid start height")
+	}
+	if input.EndHeight < 0 {
+		return errors.New("invalid end height")
+	}
+	if input.EndHeight > 0 && input.EndHeight < input.StartHeight {
+		return errors.New("end height must be greater than start height")
+	}
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+// ImportBalanceChanges creates native balance change records in bulk
+func (s EvmStore) ImportBalanceChanges(records []model.EvmBalanceChange) error {
+	return bulkImport(s.DB, queries.EvmBalanceChangesImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.ChangeIndex,
+			r.BlockHeight,
+			r.TxIndex,
+			r.Address,
+			r.Type,
+			r.Amount,
+			r.Counterparty,
+			r.Timestamp,
+		}
+	})
+}
+
+// GetBalance returns the native balance of the address in wei at the given height,
+// a nil height returns the latest indexed balance
+func (s EvmStore) GetBalance(address string, height *uint64) (types.Amount, error) {
+	scope := s.
+		Model(&model.EvmBalanceChange{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("address = ?", address)
+
+	if height != nil {
+		scope = scope.Where("block_height <= ?", *height)
+	}
+
+	result := types.Amount{}
+	err := scope.Scan(&result).Error
+
+	return result, err
+}
+
+// GetBalanceHistory returns balance changes of the address with the running balance, latest first
+func (s EvmStore) GetBalanceHistory(input *EvmBalanceHistorySearch) ([]model.EvmBalanceHistoryItem, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := []model.EvmBalanceHistoryItem{}
+
+	err := s.Raw(queries.EvmBalanceHistory,
+		input.Address,
+		input.StartHeight, input.StartHeight,
+		input.EndHeight, input.EndHeight,
+		input.Limit,
+		input.Offset,
+	).Scan(&result).Error
+
+	return result, err
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type EvmInternalTxsStore struct {
+	*gorm.DB
+}
+
+type EvmInternalTxsSearch struct {
+	TxHash      string `form:"tx_hash"`
+	Address     string `form:"address"`
+	Type        string `form:"type"`
+	WithValue   bool   `form:"with_value"`
+	StartHeight int    `form:"start_height"`
+	EndHeight   int    `form:"end_height"`
+	Limit       int    `form:"limit"`
+	Offset      int    `form:"offset"`
+	Page        int    `form:"page"`
+}
+
+func (input *EvmInternalTxsSearch) Validate() error {
+	if input.StartHeight < 0 {
+		return errors.New("invalid start height")
+	}
+	if input.EndHeight < 0 {
+		return errors.New("invalid end height")
+	}
+	if input.EndHeight > 0 && input.EndHeight < input.StartHeight {
+		return errors.New("end height must be greater than start height")
+	}
+
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+// Import creates internal transaction records in bulk
+func (s EvmInternalTxsStore) Import(records []model.EvmInternalTx) error {
+	return bulkImport(s.DB, queries.EvmInternalTxsImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.TraceIndex,
+			r.TracePath,
+			r.BlockHeight,
+			r.Type,
+			r.From,
+			r.To,
+			r.Value,
+			r.Gas,
+			r.GasUsed,
+			r.Revert,
+			r.Error,
+			r.Timestamp,
+		}
+	})
+}
+
+// Search returns internal transactions matching the search input
+func (s EvmInternalTxsStore) Search(input *EvmInternalTxsSearch) ([]model.EvmInternalTx, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.EvmInternalTx{})
+
+	if input.TxHash != "" {
+		scope = scope.Where("tx_hash = ?", input.TxHash)
+	}
+	if input.Address != "" {
+		scope = scope.Where("(from_address = ? OR to_address = ?)", input.Address, input.Address)
+	}
+	if input.Type != "" {
+		scope = scope.Where("type = ?", input.Type)
+	}
+	if input.WithValue {
+		scope = scope.Where("value > 0")
+	}
+	if input.StartHeight > 0 {
+		scope = scope.Where("block_height >= ?", input.StartHeight)
+	}
+	if input.EndHeight > 0 {
+		scope = scope.Where("block_height <= ?", input.EndHeight)
+	}
+
+	// Calls of a single transaction are listed in the execution order
+	order := "block_height DESC, tx_hash ASC, trace_index ASC"
+	if input.TxHash != "" {
+		order = "trace_index ASC"
+	}
+
+	result := []model.EvmInternalTx{}
+
+	err := scope.
+		Order(order).
+		Offset(input.Offset).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
@@ -0,0 +1,186 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type EvmLogsStore struct {
+	*gorm.DB
+}
+
+type EvmLogsSearch struct {
+	Address     string `form:"address"`
+	Topic0      string `form:"topic0"`
+	Topic1      string `form:"topic1"`
+	Topic2      string `form:"topic2"`
+	Topic3      string `form:"topic3"`
+	TxHash      string `form:"tx_hash"`
+	StartHeight int    `form:"start_height"`
+	EndHeight   int    `form:"end_height"`
+	StartTime   string `form:"start_time"`
+	EndTime     string `form:"end_time"`
+	Order       string `form:"order"`
+	Cursor      string `form:"cursor"`
+	Limit       int    `form:"limit"`
+
+	startTime *time.Time
+	endTime   *time.Time
+	cursor    *evmLogCursor
+}
+
+// evmLogCursor points to the last returned log, encoded as "height:index"
+type evmLogCursor struct {
+	height uint64
+	index  int
+}
+
+func (input *EvmLogsSearch) Validate() error {
+	if input.StartHeight < 0 {
+		return errors.New("invalid start height")
+	}
+	if input.EndHeight < 0 {
+		return errors.New("invalid end height")
+	}
+	if input.EndHeight > 0 && input.EndHeight < input.StartHeight {
+		return errors.New("end height must be greater than start height")
+	}
+
+	if input.StartTime != "" {
+		ts, err := parseTimeFilter(input.StartTime, "bod")
+		if err != nil {
+			return errors.New("invalid start time")
+		}
+		input.startTime = ts
+	}
+
+	if input.EndTime != "" {
+		ts, err := parseTimeFilter(input.EndTime, "eod")
+		if err != nil {
+			return errors.New("invalid end time")
+		}
+		if input.startTime != nil && ts.Before(*input.startTime) {
+			return errors.New("end time must be greater than start time")
+		}
+		input.endTime = ts
+	}
+
+	switch input.Order {
+	case "":
+		input.Order = "asc"
+	case "asc", "desc":
+	default:
+		return errors.New("invalid order")
+	}
+
+	if input.Cursor != "" {
+		cursor := &evmLogCursor{}
+		if _, err := fmt.Sscanf(input.Cursor, "%d:%d", &cursor.height, &cursor.index); err != nil {
+			return errors.New("invalid cursor value")
+		}
+		input.cursor = cursor
+	}
+
+	if input.Limit < 0 {
+		return errors.New("invalid limit value")
+	}
+	if input.Limit == 0 {
+		input.Limit = 100
+	}
+	if input.Limit > 1000 {
+		return errors.New("limit param max value is 1000")
+	}
+
+	return nil
+}
+
+// NextCursor returns the cursor for the page following the given logs
+func (input *EvmLogsSearch) NextCursor(logs []model.EvmLogRecord) string {
+	if len(logs) < input.Limit {
+		return ""
+	}
+	last := logs[len(logs)-1]
+	return fmt.Sprintf("%d:%d", last.BlockHeight, last.LogIndex)
+}
+
+// Import creates log records in bulk
+func (s EvmLogsStore) Import(records []model.EvmLogRecord) error {
+	return bulkImport(s.DB, queries.EvmLogsImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.BlockHeight,
+			r.LogIndex,
+			r.TxIndex,
+			r.Address,
+			r.Topic0,
+			r.Topic1,
+			r.Topic2,
+			r.Topic3,
+			r.Data,
+			r.Removed,
+			r.Timestamp,
+		}
+	})
+}
+
+// Search returns log records matching the search input
+func (s EvmLogsStore) Search(input *EvmLogsSearch) ([]model.EvmLogRecord, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.EvmLogRecord{})
+
+	if input.Address != "" {
+		scope = scope.Where("address IN (?)", strings.Split(input.Address, ","))
+	}
+
+	for idx, topic := range []string{input.Topic0, input.Topic1, input.Topic2, input.Topic3} {
+		if topic != "" {
+			scope = scope.Where(fmt.Sprintf("topic%d IN (?)", idx), strings.Split(strings.ToLower(topic), ","))
+		}
+	}
+
+	if input.TxHash != "" {
+		scope = scope.Where("tx_hash = ?", input.TxHash)
+	}
+	if input.StartHeight > 0 {
+		scope = scope.Where("block_height >= ?", input.StartHeight)
+	}
+	if input.EndHeight > 0 {
+		scope = scope.Where("block_height <= ?", input.EndHeight)
+	}
+	if input.startTime != nil {
+		scope = scope.Where("timestamp >= ?", *input.startTime)
+	}
+	if input.endTime != nil {
+		scope = scope.Where("timestamp <= ?", *input.endTime)
+	}
+
+	if cursor := input.cursor; cursor != nil {
+		if input.Order == "asc" {
+			scope = scope.Where("(block_height, log_index) > (?, ?)", cursor.height, cursor.index)
+		} else {
+			scope = scope.Where("(block_height, log_index) < (?, ?)", cursor.height, cursor.index)
+		}
+	}
+
+	result := []model.EvmLogRecord{}
+
+	err := scope.
+		Order(fmt.Sprintf("block_height %s, log_index %s", input.Order, input.Order)).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
@@ -0,0 +1,132 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type FailedContainersStore struct {
+	*gorm.DB
+}
+
+type FailedContainersSearch struct {
+	Chain  string `form:"chain"`
+	Status string `form:"status"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+	Page   int    `form:"page"`
+}
+
+func (s *FailedContainersSearch) Validate() error {
+	switch s.Status {
+	case "":
+	case model.FailedContainerStatusFailed:
+	case model.FailedContainerStatusSkipped:
+	case model.FailedContainerStatusRetry:
+	case model.FailedContainerStatusResolved:
+	default:
+		return errors.New("invalid status value")
+	}
+
+	if s.Limit < 0 {
+		return errors.New("invalid limit value")
+	}
+	if s.Limit == 0 {
+		s.Limit = 100
+	}
+	if s.Limit > 1000 {
+		return errors.New("limit param max value is 1000")
+	}
+
+	if s.Offset < 0 {
+		return errors.New("invalid offset value")
+	}
+	if s.Page < 0 {
+		return errors.New("invalid page value")
+	}
+	if s.Page > 0 {
+		s.Offset = s.Limit * (s.Page - 1)
+	}
+
+	return nil
+}
+
+// Record creates a new failed container record or bumps the attempts counter
+// of the existing one. Records resolved by an operator keep their status.
+func (s FailedContainersStore) Record(container *model.FailedContainer) (*model.FailedContainer, error) {
+	now := time.Now()
+	result := &model.FailedContainer{}
+
+	err := s.Raw(queries.FailedContainersRecord,
+		container.Chain,
+		container.IndexID,
+		container.Data,
+		container.Error,
+		container.Status,
+		container.Timestamp,
+		now,
+		now,
+	).Scan(result).Error
+
+	return result, err
+}
+
+// FindByID returns a failed container by ID
+func (s FailedContainersStore) FindByID(id int) (*model.FailedContainer, error) {
+	result := &model.FailedContainer{}
+	err := s.Model(result).First(result, "id = ?", id).Error
+	return result, checkErr(err)
+}
+
+// Search returns failed containers matching the search input
+func (s FailedContainersStore) Search(search *FailedContainersSearch) ([]model.FailedContainer, error) {
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.FailedContainer{})
+
+	if search.Chain != "" {
+		scope = scope.Where("chain = ?", search.Chain)
+	}
+	if search.Status != "" {
+		scope = scope.Where("status = ?", search.Status)
+	}
+
+	result := []model.FailedContainer{}
+
+	err := scope.
+		Order("chain ASC, index_id ASC").
+		Limit(search.Limit).
+		Offset(search.Offset).
+		Find(&result).
+		Error
+
+	return result, err
+}
+
+// UpdateStatus changes the status of a failed container
+func (s FailedContainersStore) UpdateStatus(id int, status string) error {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now(),
+	}
+	if status == model.FailedContainerStatusResolved {
+		updates["resolved_at"] = time.Now()
+	}
+
+	result := s.
+		Model(&model.FailedContainer{}).
+		Where("id = ?", id).
+		Updates(updates)
+
+	if result.Error == nil && result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return result.Error
+}
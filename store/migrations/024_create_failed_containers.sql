@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE failed_containers (
+  id          SERIAL PRIMARY KEY,
+  chain       TEXT NOT NULL,
+  index_id    INTEGER NOT NULL,
+  data        TEXT NOT NULL,
+  error       TEXT NOT NULL,
+  status      TEXT NOT NULL,
+  attempts    INTEGER NOT NULL DEFAULT 1,
+  timestamp   TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+  resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_failed_containers_chain_index ON failed_containers(chain, index_id);
+CREATE INDEX idx_failed_containers_status             ON failed_containers(status);
+
+-- +goose Down
+DROP TABLE failed_containers;
@@ -0,0 +1,41 @@
+-- +goose Up
+DROP TABLE IF EXISTS evm_logs;
+
+CREATE TABLE evm_logs (
+  tx_hash      TEXT NOT NULL,
+  block_height INTEGER NOT NULL,
+  log_index    INTEGER NOT NULL,
+  tx_index     INTEGER NOT NULL,
+  address      TEXT NOT NULL,
+  topic0       TEXT,
+  topic1       TEXT,
+  topic2       TEXT,
+  topic3       TEXT,
+  data         TEXT,
+  removed      BOOLEAN NOT NULL DEFAULT FALSE,
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (block_height, log_index)
+);
+
+CREATE INDEX idx_evm_logs_tx      ON evm_logs(tx_hash);
+CREATE INDEX idx_evm_logs_address ON evm_logs(address, block_height);
+CREATE INDEX idx_evm_logs_topic0  ON evm_logs(topic0, block_height);
+CREATE INDEX idx_evm_logs_topic1  ON evm_logs(topic1);
+CREATE INDEX idx_evm_logs_topic2  ON evm_logs(topic2);
+CREATE INDEX idx_evm_logs_topic3  ON evm_logs(topic3);
+CREATE INDEX idx_evm_logs_time    ON evm_logs(timestamp);
+
+-- +goose Down
+DROP TABLE evm_logs;
+
+CREATE TABLE evm_logs (
+  id         SERIAL PRIMARY KEY,
+  receipt_id INTEGER NOT NULL,
+  idx        INTEGER,
+  address    TEXT,
+  tx_idx     INTEGER,
+  removed    BOOLEAN,
+  topics     TEXT[],
+  data       TEXT
+);
\ No newline at end of file
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE tokens (
+  address    TEXT NOT NULL PRIMARY KEY,
+  type       TEXT NOT NULL,
+  name       TEXT,
+  symbol     TEXT,
+  decimals   INTEGER,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_tokens_type   ON tokens(type);
+CREATE INDEX idx_tokens_symbol ON tokens(symbol);
+
+CREATE TABLE token_transfers (
+  tx_hash      TEXT NOT NULL,
+  log_index    INTEGER NOT NULL,
+  block_height INTEGER NOT NULL,
+  token        TEXT NOT NULL,
+  from_address TEXT NOT NULL,
+  to_address   TEXT NOT NULL,
+  amount       DECIMAL(78, 0) NOT NULL,
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (block_height, log_index)
+);
+
+CREATE INDEX idx_token_transfers_tx    ON token_transfers(tx_hash);
+CREATE INDEX idx_token_transfers_token ON token_transfers(token, block_height);
+CREATE INDEX idx_token_transfers_from  ON token_transfers(from_address, block_height);
+CREATE INDEX idx_token_transfers_to    ON token_transfers(to_address, block_height);
+CREATE INDEX idx_token_transfers_time  ON token_transfers(timestamp);
+
+-- +goose Down
+DROP TABLE token_transfers;
+DROP TABLE tokens;
\ No newline at end of file
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE nft_transfers (
+  tx_hash      TEXT NOT NULL,
+  block_height INTEGER NOT NULL,
+  log_index    INTEGER NOT NULL,
+  batch_index  INTEGER NOT NULL DEFAULT 0,
+  token        TEXT NOT NULL,
+  token_id     DECIMAL(78, 0) NOT NULL,
+  operator     TEXT,
+  from_address TEXT NOT NULL,
+  to_address   TEXT NOT NULL,
+  amount       DECIMAL(78, 0) NOT NULL,
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (block_height, log_index, batch_index)
+);
+
+CREATE INDEX idx_nft_transfers_tx       ON nft_transfers(tx_hash);
+CREATE INDEX idx_nft_transfers_token_id ON nft_transfers(token, token_id);
+CREATE INDEX idx_nft_transfers_from     ON nft_transfers(from_address, block_height);
+CREATE INDEX idx_nft_transfers_to       ON nft_transfers(to_address, block_height);
+
+CREATE TABLE nft_owners (
+  token        TEXT NOT NULL,
+  token_id     DECIMAL(78, 0) NOT NULL,
+  owner        TEXT NOT NULL,
+  amount       DECIMAL(78, 0) NOT NULL,
+  block_height INTEGER NOT NULL,
+
+  PRIMARY KEY (token, token_id, owner)
+);
+
+CREATE INDEX idx_nft_owners_owner ON nft_owners(owner);
+
+-- +goose Down
+DROP TABLE nft_owners;
+DROP TABLE nft_transfers;
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE evm_internal_txs (
+  tx_hash      TEXT NOT NULL,
+  trace_index  INTEGER NOT NULL,
+  trace_path   INTEGER[] NOT NULL,
+  block_height INTEGER NOT NULL,
+  type         TEXT NOT NULL,
+  from_address TEXT NOT NULL,
+  to_address   TEXT NOT NULL,
+  value        DECIMAL(78, 0) NOT NULL,
+  gas          BIGINT NOT NULL,
+  gas_used     BIGINT NOT NULL,
+  revert       BOOLEAN NOT NULL DEFAULT FALSE,
+  error        TEXT,
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (tx_hash, trace_index)
+);
+
+CREATE INDEX idx_evm_internal_txs_from ON evm_internal_txs(from_address, block_height);
+CREATE INDEX idx_evm_internal_txs_to   ON evm_internal_txs(to_address, block_height);
+
+-- +goose Down
+DROP TABLE evm_internal_txs;
\ No newline at end of file
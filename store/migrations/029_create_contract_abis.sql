@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE contract_abis (
+  address    TEXT NOT NULL PRIMARY KEY,
+  name       TEXT,
+  abi        JSONB NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +goose Down
+DROP TABLE contract_abis;
\ No newline at end of file
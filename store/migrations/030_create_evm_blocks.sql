@@ -0,0 +1,56 @@
+-- +goose Up
+CREATE TABLE evm_blocks (
+  hash              TEXT NOT NULL PRIMARY KEY,
+  chain             TEXT NOT NULL,
+  height            INTEGER NOT NULL,
+  parent_hash       TEXT NOT NULL,
+  coinbase          TEXT NOT NULL,
+  state_root        TEXT NOT NULL,
+  tx_root           TEXT NOT NULL,
+  receipts_root     TEXT NOT NULL,
+  ext_data_hash     TEXT NOT NULL,
+  difficulty        DECIMAL(78, 0) NOT NULL,
+  gas_limit         BIGINT NOT NULL,
+  gas_used          BIGINT NOT NULL,
+  base_fee          DECIMAL(78, 0),
+  ext_data_gas_used DECIMAL(78, 0),
+  block_gas_cost    DECIMAL(78, 0),
+  extra_data        TEXT,
+  ext_data_size     INTEGER NOT NULL,
+  tx_count          INTEGER NOT NULL,
+  size              INTEGER NOT NULL,
+  timestamp         TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_evm_blocks_height ON evm_blocks(chain, height);
+
+CREATE TABLE evm_transactions (
+  hash                     TEXT NOT NULL PRIMARY KEY,
+  block_hash               TEXT NOT NULL,
+  block_height             INTEGER NOT NULL,
+  tx_index                 INTEGER NOT NULL,
+  type                     INTEGER NOT NULL,
+  from_address             TEXT NOT NULL,
+  to_address               TEXT,
+  nonce                    BIGINT NOT NULL,
+  value                    DECIMAL(78, 0) NOT NULL,
+  gas                      BIGINT NOT NULL,
+  gas_price                DECIMAL(78, 0) NOT NULL,
+  max_fee_per_gas          DECIMAL(78, 0),
+  max_priority_fee_per_gas DECIMAL(78, 0),
+  input                    TEXT,
+  v                        TEXT NOT NULL,
+  r                        TEXT NOT NULL,
+  s                        TEXT NOT NULL,
+  contract_creation        BOOLEAN NOT NULL DEFAULT FALSE,
+  contract_address         TEXT,
+  timestamp                TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_evm_transactions_block ON evm_transactions(block_height, tx_index);
+CREATE INDEX idx_evm_transactions_from  ON evm_transactions(from_address, block_height);
+CREATE INDEX idx_evm_transactions_to    ON evm_transactions(to_address, block_height);
+
+-- +goose Down
+DROP TABLE evm_transactions;
+DROP TABLE evm_blocks;
\ No newline at end of file
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE contracts (
+  address        TEXT NOT NULL PRIMARY KEY,
+  creator        TEXT NOT NULL,
+  creation_tx    TEXT NOT NULL,
+  block_height   INTEGER NOT NULL,
+  bytecode_hash  TEXT,
+  bytecode_size  INTEGER NOT NULL DEFAULT 0,
+  proxy_type     TEXT,
+  implementation TEXT,
+  created_at     TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_contracts_creator     ON contracts(creator);
+CREATE INDEX idx_contracts_creation_tx ON contracts(creation_tx);
+
+CREATE TABLE contract_implementations (
+  contract       TEXT NOT NULL,
+  implementation TEXT NOT NULL,
+  proxy_type     TEXT NOT NULL,
+  block_height   INTEGER NOT NULL,
+  tx_hash        TEXT NOT NULL,
+  timestamp      TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (contract, block_height, implementation)
+);
+
+CREATE INDEX idx_contract_implementations_tx ON contract_implementations(tx_hash);
+
+-- +goose Down
+DROP TABLE contract_implementations;
+DROP TABLE contracts;
\ No newline at end of file
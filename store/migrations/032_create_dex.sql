@@ -0,0 +1,67 @@
+-- +goose Up
+CREATE TABLE dex_pairs (
+  address    TEXT NOT NULL PRIMARY KEY,
+  factory    TEXT,
+  token0     TEXT NOT NULL,
+  token1     TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_dex_pairs_token0 ON dex_pairs(token0);
+CREATE INDEX idx_dex_pairs_token1 ON dex_pairs(token1);
+
+CREATE TABLE dex_swaps (
+  tx_hash      TEXT NOT NULL,
+  log_index    INTEGER NOT NULL,
+  block_height INTEGER NOT NULL,
+  pair         TEXT NOT NULL,
+  token0       TEXT NOT NULL,
+  token1       TEXT NOT NULL,
+  origin       TEXT NOT NULL,
+  sender       TEXT NOT NULL,
+  recipient    TEXT NOT NULL,
+  amount0_in   DECIMAL(78, 0) NOT NULL,
+  amount1_in   DECIMAL(78, 0) NOT NULL,
+  amount0_out  DECIMAL(78, 0) NOT NULL,
+  amount1_out  DECIMAL(78, 0) NOT NULL,
+  reserve0     DECIMAL(78, 0),
+  reserve1     DECIMAL(78, 0),
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (block_height, log_index)
+);
+
+CREATE INDEX idx_dex_swaps_tx        ON dex_swaps(tx_hash);
+CREATE INDEX idx_dex_swaps_pair      ON dex_swaps(pair, block_height);
+CREATE INDEX idx_dex_swaps_origin    ON dex_swaps(origin, block_height);
+CREATE INDEX idx_dex_swaps_sender    ON dex_swaps(sender, block_height);
+CREATE INDEX idx_dex_swaps_recipient ON dex_swaps(recipient, block_height);
+
+CREATE TABLE dex_liquidity_events (
+  tx_hash      TEXT NOT NULL,
+  log_index    INTEGER NOT NULL,
+  block_height INTEGER NOT NULL,
+  type         TEXT NOT NULL,
+  pair         TEXT NOT NULL,
+  token0       TEXT NOT NULL,
+  token1       TEXT NOT NULL,
+  origin       TEXT NOT NULL,
+  sender       TEXT NOT NULL,
+  recipient    TEXT,
+  amount0      DECIMAL(78, 0) NOT NULL,
+  amount1      DECIMAL(78, 0) NOT NULL,
+  reserve0     DECIMAL(78, 0),
+  reserve1     DECIMAL(78, 0),
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (block_height, log_index)
+);
+
+CREATE INDEX idx_dex_liquidity_events_tx     ON dex_liquidity_events(tx_hash);
+CREATE INDEX idx_dex_liquidity_events_pair   ON dex_liquidity_events(pair, block_height);
+CREATE INDEX idx_dex_liquidity_events_origin ON dex_liquidity_events(origin, block_height);
+
+-- +goose Down
+DROP TABLE dex_liquidity_events;
+DROP TABLE dex_swaps;
+DROP TABLE dex_pairs;
\ No newline at end of file
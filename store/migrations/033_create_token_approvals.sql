@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE token_approvals (
+  tx_hash      TEXT NOT NULL,
+  block_height INTEGER NOT NULL,
+  log_index    INTEGER NOT NULL,
+  token        TEXT NOT NULL,
+  owner        TEXT NOT NULL,
+  spender      TEXT NOT NULL,
+  amount       DECIMAL(78, 0) NOT NULL,
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (block_height, log_index)
+);
+
+CREATE INDEX idx_token_approvals_tx  ON token_approvals(tx_hash);
+CREATE INDEX idx_token_approvals_key ON token_approvals(token, owner, spender);
+
+CREATE TABLE token_allowances (
+  token        TEXT NOT NULL,
+  owner        TEXT NOT NULL,
+  spender      TEXT NOT NULL,
+  amount       DECIMAL(78, 0) NOT NULL,
+  block_height INTEGER NOT NULL,
+  tx_hash      TEXT NOT NULL,
+  updated_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (token, owner, spender)
+);
+
+CREATE INDEX idx_token_allowances_owner   ON token_allowances(owner);
+CREATE INDEX idx_token_allowances_spender ON token_allowances(spender);
+
+-- +goose Down
+DROP TABLE token_allowances;
+DROP TABLE token_approvals;
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE evm_balance_changes (
+  tx_hash      TEXT NOT NULL,
+  change_index INTEGER NOT NULL,
+  block_height INTEGER NOT NULL,
+  tx_index     INTEGER NOT NULL,
+  address      TEXT NOT NULL,
+  type         TEXT NOT NULL,
+  amount       DECIMAL(78, 0) NOT NULL,
+  counterparty TEXT,
+  timestamp    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+  PRIMARY KEY (tx_hash, change_index)
+);
+
+CREATE INDEX idx_evm_balance_changes_address ON evm_balance_changes(address, block_height, tx_index, change_index);
+
+-- +goose Down
+DROP TABLE evm_balance_changes;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE output_type ADD VALUE IF NOT EXISTS 'reward';
+
+CREATE INDEX idx_rewards_transaction_id ON rewards(transaction_id);
+CREATE INDEX idx_transaction_outputs_reward ON transaction_outputs(tx_id) WHERE reward;
+
+-- +goose Down
+DROP INDEX idx_transaction_outputs_reward;
+DROP INDEX idx_rewards_transaction_id;
\ No newline at end of file
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE staking_periods (
+  id              TEXT NOT NULL PRIMARY KEY,
+  chain           TEXT NOT NULL,
+  type            TEXT NOT NULL,
+  node_id         TEXT NOT NULL,
+  stake_amount    BIGINT NOT NULL,
+  delegation_fee  DECIMAL,
+  reward_address  TEXT,
+  start_time      TIMESTAMP WITH TIME ZONE NOT NULL,
+  end_time        TIMESTAMP WITH TIME ZONE NOT NULL,
+  added_height    INTEGER NOT NULL,
+  added_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+  finished_height INTEGER,
+  finished_at     TIMESTAMP WITH TIME ZONE,
+  reward_tx_id    TEXT,
+  rewarded        BOOLEAN
+);
+
+CREATE INDEX idx_staking_periods_node_id ON staking_periods(node_id, start_time);
+CREATE INDEX idx_staking_periods_time ON staking_periods(start_time, end_time);
+CREATE INDEX idx_staking_periods_added_height ON staking_periods(chain, added_height);
+CREATE INDEX idx_staking_periods_finished_height ON staking_periods(chain, finished_height);
+
+-- +goose Down
+DROP TABLE staking_periods;
\ No newline at end of file
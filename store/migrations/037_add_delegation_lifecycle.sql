@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE delegations
+  ADD COLUMN status         TEXT NOT NULL DEFAULT 'active',
+  ADD COLUMN delegation_fee DECIMAL,
+  ADD COLUMN rewarded       BOOLEAN,
+  ADD COLUMN reward_amount  BIGINT,
+  ADD COLUMN reward_tx_id   TEXT,
+  ADD COLUMN ended_at       TIMESTAMP WITH TIME ZONE;
+
+UPDATE delegations SET status = 'ended', ended_at = active_end_time WHERE active = FALSE;
+
+CREATE INDEX idx_delegations_status ON delegations(status, active_start_time);
+
+-- +goose Down
+DROP INDEX idx_delegations_status;
+
+ALTER TABLE delegations
+  DROP COLUMN status,
+  DROP COLUMN delegation_fee,
+  DROP COLUMN rewarded,
+  DROP COLUMN reward_amount,
+  DROP COLUMN reward_tx_id,
+  DROP COLUMN ended_at;
\ No newline at end of file
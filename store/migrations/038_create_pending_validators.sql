@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE pending_validators (
+  tx_id             TEXT NOT NULL PRIMARY KEY,
+  node_id           TEXT NOT NULL,
+  stake_amount      BIGINT NOT NULL,
+  reward_address    TEXT,
+  delegation_fee    DECIMAL NOT NULL,
+  delegations_count INTEGER NOT NULL DEFAULT 0,
+  delegated_amount  BIGINT NOT NULL DEFAULT 0,
+  capacity          BIGINT NOT NULL DEFAULT 0,
+  status            TEXT NOT NULL,
+  start_time        TIMESTAMP WITH TIME ZONE NOT NULL,
+  end_time          TIMESTAMP WITH TIME ZONE NOT NULL,
+  first_height      INTEGER,
+  last_height       INTEGER,
+  created_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+  updated_at        TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_pending_validators_status ON pending_validators(status, start_time);
+CREATE INDEX idx_pending_validators_node_id ON pending_validators(node_id);
+
+-- +goose Down
+DROP TABLE pending_validators;
\ No newline at end of file
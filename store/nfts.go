@@ -0,0 +1,211 @@
+package store
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type NftsStore struct {
+	*gorm.DB
+}
+
+type NftOwnersSearch struct {
+	Token   string `form:"token"`
+	TokenID string `form:"token_id"`
+	Owner   string `form:"owner"`
+	Limit   int    `form:"limit"`
+	Offset  int    `form:"offset"`
+	Page    int    `form:"page"`
+}
+
+type NftTransfersSearch struct {
+	Token   string `form:"token"`
+	TokenID string `form:"token_id"`
+	Address string `form:"address"`
+	Order   string `form:"order"`
+	Limit   int    `form:"limit"`
+	Offset  int    `form:"offset"`
+	Page    int    `form:"page"`
+}
+
+// NftKey identifies a single token of a NFT collection
+type NftKey struct {
+	Token   string
+	TokenID string
+}
+
+func (input *NftOwnersSearch) Validate() error {
+	if err := validateTokenID(input.TokenID); err != nil {
+		return err
+	}
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+func (input *NftTransfersSearch) Validate() error {
+	if err := validateTokenID(input.TokenID); err != nil {
+		return err
+	}
+
+	switch input.Order {
+	case "":
+		input.Order = "desc"
+	case "asc", "desc":
+	default:
+		return errors.New("invalid order")
+	}
+
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+func validateTokenID(id string) error {
+	if id == "" {
+		return nil
+	}
+	if val, ok := new(big.Int).SetString(id, 10); !ok || val.Sign() < 0 {
+		return errors.New("invalid token id")
+	}
+	return nil
+}
+
+func validatePagination(limit *int, offset *int, page int) error {
+	if *limit < 0 {
+		return errors.New("invalid limit value")
+	}
+	if *limit == 0 {
+		*limit = 100
+	}
+	if *limit > 1000 {
+		return errors.New("limit param max value is 1000")
+	}
+
+	if *offset < 0 {
+		return errors.New("invalid offset value")
+	}
+	if page < 0 {
+		return errors.New("invalid page value")
+	}
+	if page > 0 {
+		*offset = *limit * (page - 1)
+	}
+
+	return nil
+}
+
+// ImportTransfers creates NFT transfer records in bulk and applies their balance
+// changes to the current owners of the transferred tokens
+func (s NftsStore) ImportTransfers(records []model.NftTransfer) error {
+	err := bulkImport(s.DB, queries.NftTransfersImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.BlockHeight,
+			r.LogIndex,
+			r.BatchIndex,
+			r.Token,
+			r.TokenID,
+			r.Operator,
+			r.From,
+			r.To,
+			r.Amount,
+			r.Timestamp,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]NftKey, len(records))
+	for idx, r := range records {
+		keys[idx] = NftKey{Token: r.Token, TokenID: r.TokenID.String()}
+	}
+
+	return deleteEmptyNftOwners(s.DB, keys)
+}
+
+// SearchOwners returns current NFT owners matching the search input
+func (s NftsStore) SearchOwners(input *NftOwnersSearch) ([]model.NftOwner, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Owners of tokens transferred before the indexed range may have a negative balance
+	scope := s.Model(&model.NftOwner{}).Where("amount > 0")
+
+	if input.Token != "" {
+		scope = scope.Where("token = ?", input.Token)
+	}
+	if input.TokenID != "" {
+		scope = scope.Where("token_id = ?", input.TokenID)
+	}
+	if input.Owner != "" {
+		scope = scope.Where("owner = ?", input.Owner)
+	}
+
+	result := []model.NftOwner{}
+
+	err := scope.
+		Order("token ASC, token_id ASC, owner ASC").
+		Offset(input.Offset).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
+
+// SearchTransfers returns NFT transfers matching the search input
+func (s NftsStore) SearchTransfers(input *NftTransfersSearch) ([]model.NftTransfer, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.NftTransfer{})
+
+	if input.Token != "" {
+		scope = scope.Where("token = ?", input.Token)
+	}
+	if input.TokenID != "" {
+		scope = scope.Where("token_id = ?", input.TokenID)
+	}
+	if input.Address != "" {
+		scope = scope.Where("(from_address = ? OR to_address = ?)", input.Address, input.Address)
+	}
+
+	result := []model.NftTransfer{}
+
+	err := scope.
+		Order("block_height " + input.Order + ", log_index " + input.Order + ", batch_index " + input.Order).
+		Offset(input.Offset).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
+
+// deleteEmptyNftOwners removes owners of the given tokens left without a balance
+func deleteEmptyNftOwners(db *gorm.DB, keys []NftKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	tokens, ids := nftKeyArrays(keys)
+	return db.Exec(queries.NftOwnersDeleteEmpty, tokens, ids).Error
+}
+
+func nftKeyArrays(keys []NftKey) (pq.StringArray, pq.StringArray) {
+	tokens := make(pq.StringArray, len(keys))
+	ids := make(pq.StringArray, len(keys))
+	for idx, key := range keys {
+		tokens[idx] = key.Token
+		ids[idx] = key.TokenID
+	}
+	return tokens, ids
+}
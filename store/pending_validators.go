@@ -0,0 +1,109 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type UpcomingValidatorsSearch struct {
+	Days   int `form:"days"`
+	Limit  int `form:"limit"`
+	Offset int `form:"offset"`
+	Page   int `form:"page"`
+}
+
+func (input *UpcomingValidatorsSearch) Validate() error {
+	if input.Days < 0 {
+		return errors.New("invalid days value")
+	}
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+// ImportPending imports the pending validators snapshot, promotes validators that
+// joined the current set and drops the ones that left the pending set otherwise.
+func (s ValidatorsStore) ImportPending(records []model.PendingValidator, currentTxIDs []string, syncTime time.Time) error {
+	err := bulkImport(s.DB, queries.PendingValidatorsImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxID,
+			r.NodeID,
+			r.StakeAmount,
+			r.RewardAddress,
+			r.DelegationFee,
+			r.DelegationsCount,
+			r.DelegatedAmount,
+			r.Capacity,
+			r.Status,
+			r.StartTime,
+			r.EndTime,
+			r.FirstHeight,
+			r.LastHeight,
+			r.CreatedAt,
+			r.UpdatedAt,
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(currentTxIDs) > 0 {
+		if err := s.Exec(queries.PendingValidatorsPromote, syncTime, currentTxIDs).Error; err != nil {
+			return err
+		}
+	}
+	if err := s.Exec(queries.PendingValidatorsDrop, syncTime).Error; err != nil {
+		return err
+	}
+
+	// Pending stakers are reported without reward owners, those come from the indexed transactions
+	return s.Exec(queries.PendingValidatorsRewardAddresses).Error
+}
+
+// SearchPending returns validators of the pending set
+func (s ValidatorsStore) SearchPending(search ValidatorsSearch) ([]model.PendingValidator, error) {
+	scope := s.
+		Model(&model.PendingValidator{}).
+		Where("status = ?", model.ValidatorStatusPending).
+		Order("stake_amount")
+
+	if search.RewardAddress != "" {
+		scope = scope.Where("reward_address = ?", search.RewardAddress)
+	}
+
+	result := []model.PendingValidator{}
+	err := scope.Find(&result).Error
+
+	return result, checkErr(err)
+}
+
+// Upcoming returns pending validators in the order of their start time
+func (s ValidatorsStore) Upcoming(search *UpcomingValidatorsSearch) ([]model.PendingValidator, error) {
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	scope := s.
+		Model(&model.PendingValidator{}).
+		Where("status = ? AND start_time > ?", model.ValidatorStatusPending, now)
+
+	if search.Days > 0 {
+		scope = scope.Where("start_time <= ?", now.AddDate(0, 0, search.Days))
+	}
+
+	result := []model.PendingValidator{}
+
+	err := scope.
+		Order("start_time ASC, tx_id ASC").
+		Offset(search.Offset).
+		Limit(search.Limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
@@ -114,6 +114,20 @@ func (s *PlatformStore) LastBlock(chain string) (*model.Block, error) {
 	return result, checkErr(err)
 }
 
+// FirstBlockSince returns the lowest block created at or after the given time
+func (s *PlatformStore) FirstBlockSince(chain string, start time.Time) (*model.Block, error) {
+	result := &model.Block{}
+
+	err := s.
+		Model(result).
+		Where("chain = ? AND timestamp >= ?", chain, start).
+		Order("height ASC").
+		Take(result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // CreateBlock creates a new block
 func (s *PlatformStore) CreateBlock(block *model.Block) error {
 	return s.
@@ -0,0 +1,10 @@
+UPDATE contracts
+SET
+  implementation = ?,
+  proxy_type = ?
+WHERE
+  address = ?
+  AND NOT EXISTS (
+    SELECT 1 FROM contract_implementations
+    WHERE contract = contracts.address AND block_height > ?
+  )
\ No newline at end of file
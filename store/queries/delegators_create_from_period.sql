@@ -0,0 +1,43 @@
+INSERT INTO delegations (
+  reference_id,
+  node_id,
+  stake_amount,
+  reward_address,
+  active,
+  active_start_time,
+  active_end_time,
+  status,
+  delegation_fee,
+  first_height,
+  last_height,
+  created_at,
+  updated_at
+)
+SELECT
+  delegator.id,
+  delegator.node_id,
+  delegator.stake_amount,
+  COALESCE(delegator.reward_address, ''),
+  FALSE,
+  delegator.start_time,
+  delegator.end_time,
+  'ended',
+  (
+    SELECT validator.delegation_fee
+    FROM staking_periods validator
+    WHERE
+      validator.type = 'validator'
+      AND validator.node_id = delegator.node_id
+      AND validator.added_at <= delegator.added_at
+    ORDER BY validator.added_at DESC
+    LIMIT 1
+  ),
+  delegator.added_height,
+  delegator.added_height,
+  delegator.added_at,
+  NOW()
+FROM staking_periods delegator
+WHERE
+  delegator.id = ?
+  AND delegator.type = 'delegator'
+ON CONFLICT (reference_id) DO NOTHING
\ No newline at end of file
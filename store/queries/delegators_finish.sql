@@ -0,0 +1,11 @@
+UPDATE delegations
+SET
+  active        = FALSE,
+  status        = 'ended',
+  rewarded      = ?,
+  reward_amount = ?,
+  reward_tx_id  = ?,
+  ended_at      = ?,
+  updated_at    = NOW()
+WHERE
+  reference_id = ?
\ No newline at end of file
@@ -7,6 +7,8 @@ INSERT INTO delegations (
   active,
   active_start_time,
   active_end_time,
+  status,
+  delegation_fee,
   first_height,
   last_height,
   created_at,
@@ -19,9 +21,17 @@ SET
   node_id           = excluded.node_id,
   stake_amount      = excluded.stake_amount,
   potential_reward  = excluded.potential_reward,
-  reward_address    = excluded.reward_address,
-  active            = excluded.active,
+  reward_address    = COALESCE(NULLIF(excluded.reward_address, ''), delegations.reward_address),
+  active            = CASE
+                        WHEN delegations.status = 'ended' OR delegations.reward_tx_id IS NOT NULL THEN delegations.active
+                        ELSE excluded.active
+                      END,
   active_start_time = excluded.active_start_time,
   active_end_time   = excluded.active_end_time,
+  status            = CASE
+                        WHEN delegations.status = 'ended' OR delegations.reward_tx_id IS NOT NULL THEN delegations.status
+                        ELSE excluded.status
+                      END,
+  delegation_fee    = COALESCE(delegations.delegation_fee, excluded.delegation_fee),
   last_height       = excluded.last_height,
   updated_at        = excluded.updated_at
@@ -0,0 +1,8 @@
+UPDATE delegations
+SET
+  active   = FALSE,
+  status   = 'ended',
+  ended_at = COALESCE(ended_at, active_end_time)
+WHERE
+  status IN ('pending', 'active')
+  AND updated_at < ?
\ No newline at end of file
@@ -0,0 +1,8 @@
+UPDATE delegations
+SET
+  reward_address = rewards_owner_addresses.address
+FROM rewards_owner_addresses
+WHERE
+  rewards_owner_addresses.id = delegations.reference_id
+  AND rewards_owner_addresses.index = 0
+  AND delegations.reward_address = ''
\ No newline at end of file
@@ -0,0 +1,19 @@
+INSERT INTO dex_liquidity_events (
+  tx_hash,
+  block_height,
+  log_index,
+  type,
+  pair,
+  token0,
+  token1,
+  origin,
+  sender,
+  recipient,
+  amount0,
+  amount1,
+  reserve0,
+  reserve1,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (block_height, log_index) DO NOTHING
\ No newline at end of file
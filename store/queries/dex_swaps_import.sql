@@ -0,0 +1,20 @@
+INSERT INTO dex_swaps (
+  tx_hash,
+  block_height,
+  log_index,
+  pair,
+  token0,
+  token1,
+  origin,
+  sender,
+  recipient,
+  amount0_in,
+  amount1_in,
+  amount0_out,
+  amount1_out,
+  reserve0,
+  reserve1,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (block_height, log_index) DO NOTHING
\ No newline at end of file
@@ -0,0 +1,13 @@
+INSERT INTO evm_balance_changes (
+  tx_hash,
+  change_index,
+  block_height,
+  tx_index,
+  address,
+  type,
+  amount,
+  counterparty,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (tx_hash, change_index) DO NOTHING
\ No newline at end of file
@@ -0,0 +1,14 @@
+SELECT *
+FROM (
+  SELECT
+    evm_balance_changes.*,
+    SUM(amount) OVER (ORDER BY block_height, tx_index, change_index) AS balance
+  FROM evm_balance_changes
+  WHERE address = ?
+) history
+WHERE
+  (? = 0 OR block_height >= ?)
+  AND (? = 0 OR block_height <= ?)
+ORDER BY block_height DESC, tx_index DESC, change_index DESC
+LIMIT ?
+OFFSET ?
\ No newline at end of file
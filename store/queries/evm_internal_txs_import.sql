@@ -0,0 +1,17 @@
+INSERT INTO evm_internal_txs (
+  tx_hash,
+  trace_index,
+  trace_path,
+  block_height,
+  type,
+  from_address,
+  to_address,
+  value,
+  gas,
+  gas_used,
+  revert,
+  error,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (tx_hash, trace_index) DO NOTHING
\ No newline at end of file
@@ -0,0 +1,16 @@
+INSERT INTO evm_logs (
+  tx_hash,
+  block_height,
+  log_index,
+  tx_index,
+  address,
+  topic0,
+  topic1,
+  topic2,
+  topic3,
+  data,
+  removed,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (block_height, log_index) DO NOTHING
\ No newline at end of file
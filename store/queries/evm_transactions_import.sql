@@ -0,0 +1,24 @@
+INSERT INTO evm_transactions (
+  hash,
+  block_hash,
+  block_height,
+  tx_index,
+  type,
+  from_address,
+  to_address,
+  nonce,
+  value,
+  gas,
+  gas_price,
+  max_fee_per_gas,
+  max_priority_fee_per_gas,
+  input,
+  v,
+  r,
+  s,
+  contract_creation,
+  contract_address,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (hash) DO NOTHING
\ No newline at end of file
@@ -0,0 +1,22 @@
+INSERT INTO failed_containers (
+  chain,
+  index_id,
+  data,
+  error,
+  status,
+  attempts,
+  timestamp,
+  created_at,
+  updated_at
+)
+VALUES (?, ?, ?, ?, ?, 1, ?, ?, ?)
+ON CONFLICT (chain, index_id) DO UPDATE
+SET
+  error      = excluded.error,
+  attempts   = failed_containers.attempts + 1,
+  updated_at = excluded.updated_at,
+  status     = CASE
+                 WHEN failed_containers.status = 'resolved' THEN failed_containers.status
+                 ELSE excluded.status
+               END
+RETURNING *
\ No newline at end of file
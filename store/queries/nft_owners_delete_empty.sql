@@ -0,0 +1,4 @@
+DELETE FROM nft_owners
+WHERE
+  (token, token_id) IN (SELECT * FROM UNNEST(?::TEXT[], ?::TEXT[]::DECIMAL[]))
+  AND amount = 0
\ No newline at end of file
@@ -0,0 +1,36 @@
+WITH transfers AS (
+  INSERT INTO nft_transfers (
+    tx_hash,
+    block_height,
+    log_index,
+    batch_index,
+    token,
+    token_id,
+    operator,
+    from_address,
+    to_address,
+    amount,
+    timestamp
+  )
+  VALUES @values
+  ON CONFLICT (block_height, log_index, batch_index) DO NOTHING
+  RETURNING token, token_id, from_address, to_address, amount, block_height
+)
+INSERT INTO nft_owners (token, token_id, owner, amount, block_height)
+SELECT
+  token,
+  token_id,
+  owner,
+  SUM(amount),
+  MAX(block_height)
+FROM (
+  SELECT token, token_id, to_address AS owner, amount, block_height FROM transfers
+  UNION ALL
+  SELECT token, token_id, from_address AS owner, -amount, block_height FROM transfers
+) deltas
+WHERE owner <> '0x0000000000000000000000000000000000000000'
+GROUP BY token, token_id, owner
+ON CONFLICT (token, token_id, owner) DO UPDATE
+SET
+  amount       = nft_owners.amount + excluded.amount,
+  block_height = GREATEST(nft_owners.block_height, excluded.block_height)
\ No newline at end of file
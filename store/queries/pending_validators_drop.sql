@@ -0,0 +1,6 @@
+UPDATE pending_validators
+SET
+  status = 'dropped'
+WHERE
+  status = 'pending'
+  AND updated_at < ?
\ No newline at end of file
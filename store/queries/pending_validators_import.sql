@@ -0,0 +1,32 @@
+INSERT INTO pending_validators (
+  tx_id,
+  node_id,
+  stake_amount,
+  reward_address,
+  delegation_fee,
+  delegations_count,
+  delegated_amount,
+  capacity,
+  status,
+  start_time,
+  end_time,
+  first_height,
+  last_height,
+  created_at,
+  updated_at
+)
+VALUES @values
+
+ON CONFLICT (tx_id) DO UPDATE
+SET
+  stake_amount      = excluded.stake_amount,
+  reward_address    = COALESCE(excluded.reward_address, pending_validators.reward_address),
+  delegation_fee    = excluded.delegation_fee,
+  delegations_count = excluded.delegations_count,
+  delegated_amount  = excluded.delegated_amount,
+  capacity          = excluded.capacity,
+  status            = excluded.status,
+  start_time        = excluded.start_time,
+  end_time          = excluded.end_time,
+  last_height       = excluded.last_height,
+  updated_at        = excluded.updated_at
\ No newline at end of file
@@ -0,0 +1,7 @@
+UPDATE pending_validators
+SET
+  status     = 'active',
+  updated_at = ?
+WHERE
+  status = 'pending'
+  AND tx_id IN (?)
\ No newline at end of file
@@ -0,0 +1,8 @@
+UPDATE pending_validators
+SET
+  reward_address = rewards_owner_addresses.address
+FROM rewards_owner_addresses
+WHERE
+  rewards_owner_addresses.id = pending_validators.tx_id
+  AND rewards_owner_addresses.index = 0
+  AND pending_validators.reward_address IS NULL
\ No newline at end of file
@@ -1,10 +0,0 @@
-INSERT INTO evm_logs (
-  receipt_id,
-  idx,
-  tx_idx,
-  address,
-  removed,
-  topics,
-  data
-)
-VALUES @values
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM assets WHERE asset_id IN (SELECT id FROM reindex_txs)
\ No newline at end of file
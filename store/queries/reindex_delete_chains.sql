@@ -0,0 +1 @@
+DELETE FROM chains WHERE chain_id IN (SELECT id FROM reindex_txs)
\ No newline at end of file
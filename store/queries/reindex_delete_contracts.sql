@@ -0,0 +1 @@
+DELETE FROM contracts WHERE creation_tx IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM evm_internal_txs WHERE tx_hash IN (SELECT id FROM reindex_txs)
\ No newline at end of file
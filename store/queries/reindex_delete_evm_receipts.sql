@@ -0,0 +1 @@
+DELETE FROM evm_receipts WHERE id IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM evm_transactions WHERE hash IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1,4 @@
+DELETE FROM evm_balance_changes
+WHERE
+  tx_hash IN (SELECT id FROM reindex_txs)
+  AND type NOT IN ('import', 'export')
\ No newline at end of file
@@ -0,0 +1,21 @@
+WITH transfers AS (
+  DELETE FROM nft_transfers
+  WHERE tx_hash IN (SELECT id FROM reindex_txs)
+  RETURNING token, token_id, from_address, to_address, amount, block_height
+)
+INSERT INTO nft_owners (token, token_id, owner, amount, block_height)
+SELECT
+  token,
+  token_id,
+  owner,
+  SUM(amount),
+  MIN(block_height)
+FROM (
+  SELECT token, token_id, to_address AS owner, -amount AS amount, block_height FROM transfers
+  UNION ALL
+  SELECT token, token_id, from_address AS owner, amount, block_height FROM transfers
+) deltas
+WHERE owner <> '0x0000000000000000000000000000000000000000'
+GROUP BY token, token_id, owner
+ON CONFLICT (token, token_id, owner) DO UPDATE
+SET amount = nft_owners.amount + excluded.amount
\ No newline at end of file
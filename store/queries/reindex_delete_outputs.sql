@@ -0,0 +1 @@
+DELETE FROM transaction_outputs WHERE tx_id IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1,4 @@
+DELETE FROM transaction_outputs
+WHERE
+  reward = TRUE
+  AND tx_id IN (SELECT id FROM rewards WHERE transaction_id IN (SELECT id FROM reindex_txs))
\ No newline at end of file
@@ -0,0 +1,4 @@
+DELETE FROM rewards
+WHERE
+  id IN (SELECT id FROM reindex_txs)
+  OR transaction_id IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM rewards_owner_outputs WHERE transaction_id IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1 @@
+DELETE FROM staking_periods WHERE chain = ? AND added_height >= ?
\ No newline at end of file
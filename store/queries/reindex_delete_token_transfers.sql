@@ -0,0 +1 @@
+DELETE FROM token_transfers WHERE tx_hash IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE reindex_txs
\ No newline at end of file
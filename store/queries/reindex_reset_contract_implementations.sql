@@ -0,0 +1,17 @@
+UPDATE contracts
+SET
+  implementation = latest.implementation,
+  proxy_type = latest.proxy_type
+FROM (
+  SELECT DISTINCT ON (address)
+    contracts.address,
+    contract_implementations.implementation,
+    contract_implementations.proxy_type
+  FROM contracts
+  LEFT JOIN contract_implementations ON contract_implementations.contract = contracts.address
+  WHERE contracts.implementation IS NOT NULL
+  ORDER BY contracts.address, contract_implementations.block_height DESC
+) latest
+WHERE
+  contracts.address = latest.address
+  AND contracts.implementation IS DISTINCT FROM latest.implementation
\ No newline at end of file
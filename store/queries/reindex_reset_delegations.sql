@@ -0,0 +1,14 @@
+UPDATE delegations
+SET
+  rewarded      = NULL,
+  reward_amount = NULL,
+  reward_tx_id  = NULL,
+  ended_at      = active_end_time
+WHERE
+  reference_id IN (
+    SELECT id
+    FROM staking_periods
+    WHERE
+      chain = ?
+      AND finished_height >= ?
+  )
\ No newline at end of file
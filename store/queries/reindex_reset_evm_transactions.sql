@@ -0,0 +1,8 @@
+UPDATE transactions
+SET
+  status   = 'accepted',
+  fee      = 0,
+  metadata = metadata - ARRAY['error', 'revert_reason', 'gas_used', 'fee', 'burned_fee', 'priority_fee']
+WHERE
+  id IN (SELECT id FROM reindex_txs)
+  AND type = 'c_evm'
\ No newline at end of file
@@ -0,0 +1,10 @@
+UPDATE nft_owners
+SET block_height = COALESCE((
+  SELECT MAX(block_height)
+  FROM nft_transfers
+  WHERE
+    nft_transfers.token = nft_owners.token
+    AND nft_transfers.token_id = nft_owners.token_id
+    AND (nft_transfers.from_address = nft_owners.owner OR nft_transfers.to_address = nft_owners.owner)
+), 0)
+WHERE (token, token_id) IN (SELECT * FROM UNNEST(?::TEXT[], ?::TEXT[]::DECIMAL[]))
\ No newline at end of file
@@ -0,0 +1,9 @@
+UPDATE staking_periods
+SET
+  finished_height = NULL,
+  finished_at     = NULL,
+  reward_tx_id    = NULL,
+  rewarded        = NULL
+WHERE
+  chain = ?
+  AND finished_height >= ?
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT DISTINCT token, owner, spender FROM token_approvals WHERE tx_hash IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT DISTINCT token, token_id::TEXT AS token_id FROM nft_transfers WHERE tx_hash IN (SELECT id FROM reindex_txs)
\ No newline at end of file
@@ -0,0 +1,5 @@
+CREATE TEMP TABLE reindex_txs ON COMMIT DROP AS
+SELECT id FROM transactions
+WHERE
+  chain = ?
+  AND block_height >= ?
\ No newline at end of file
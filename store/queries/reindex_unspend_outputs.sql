@@ -0,0 +1,6 @@
+UPDATE transaction_outputs
+SET
+  spent = FALSE,
+  spent_tx_id = NULL
+WHERE
+  spent_tx_id IN (SELECT id FROM reindex_txs)
\ No newline at end of file
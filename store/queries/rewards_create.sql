@@ -0,0 +1,8 @@
+INSERT INTO rewards (id, transaction_id, rewarded, rewarded_at, processed_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE
+SET
+  transaction_id = excluded.transaction_id,
+  rewarded       = excluded.rewarded,
+  rewarded_at    = excluded.rewarded_at,
+  processed_at   = excluded.processed_at
\ No newline at end of file
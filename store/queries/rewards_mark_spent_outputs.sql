@@ -0,0 +1,8 @@
+UPDATE transaction_outputs
+SET
+  spent = TRUE,
+  spent_tx_id = transaction_inputs.tx_id
+FROM transaction_inputs
+WHERE
+  transaction_outputs.id = transaction_inputs.id
+  AND transaction_outputs.id IN (?)
\ No newline at end of file
@@ -0,0 +1,10 @@
+SELECT transactions.*
+FROM transactions
+LEFT JOIN rewards ON rewards.transaction_id = transactions.id
+WHERE
+  transactions.chain = ?
+  AND transactions.type = 'p_reward_validator'
+  AND transactions.status = 'accepted'
+  AND rewards.id IS NULL
+ORDER BY transactions.block_height ASC
+LIMIT ?
\ No newline at end of file
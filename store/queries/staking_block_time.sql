@@ -0,0 +1,7 @@
+SELECT blocks.timestamp
+FROM blocks
+INNER JOIN chains ON chains.chain_id = blocks.chain
+WHERE
+  chains.name = 'P'
+  AND blocks.height = ?
+LIMIT 1
\ No newline at end of file
@@ -0,0 +1,23 @@
+WITH params AS (
+  SELECT ?::TIMESTAMPTZ AS at
+)
+SELECT
+  staking_periods.id AS reference_id,
+  staking_periods.node_id,
+  staking_periods.stake_amount,
+  staking_periods.reward_address,
+  TRUE AS active,
+  'active' AS status,
+  staking_periods.start_time AS active_start_time,
+  staking_periods.end_time AS active_end_time,
+  staking_periods.added_height AS first_height
+FROM staking_periods, params
+WHERE
+  staking_periods.type = 'delegator'
+  AND staking_periods.added_at <= params.at
+  AND staking_periods.start_time <= params.at
+  AND staking_periods.end_time > params.at
+  AND (staking_periods.finished_at IS NULL OR staking_periods.finished_at > params.at)
+  AND (? = '' OR staking_periods.node_id = ?)
+  AND (? = '' OR staking_periods.reward_address = ?)
+ORDER BY staking_periods.added_at DESC
\ No newline at end of file
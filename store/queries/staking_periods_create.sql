@@ -0,0 +1,24 @@
+INSERT INTO staking_periods (
+  id,
+  chain,
+  type,
+  node_id,
+  stake_amount,
+  delegation_fee,
+  reward_address,
+  start_time,
+  end_time,
+  added_height,
+  added_at
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (id) DO UPDATE
+SET
+  node_id        = excluded.node_id,
+  stake_amount   = excluded.stake_amount,
+  delegation_fee = excluded.delegation_fee,
+  reward_address = excluded.reward_address,
+  start_time     = excluded.start_time,
+  end_time       = excluded.end_time,
+  added_height   = excluded.added_height,
+  added_at       = excluded.added_at
\ No newline at end of file
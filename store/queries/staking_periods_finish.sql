@@ -0,0 +1,8 @@
+UPDATE staking_periods
+SET
+  finished_height = ?,
+  finished_at     = ?,
+  reward_tx_id    = ?,
+  rewarded        = ?
+WHERE
+  id = ?
\ No newline at end of file
@@ -0,0 +1,42 @@
+WITH params AS (
+  SELECT ?::TIMESTAMPTZ AS at
+),
+active AS (
+  SELECT staking_periods.*
+  FROM staking_periods, params
+  WHERE
+    added_at <= params.at
+    AND start_time <= params.at
+    AND end_time > params.at
+    AND (finished_at IS NULL OR finished_at > params.at)
+),
+delegated AS (
+  SELECT node_id, COUNT(1) AS delegations_count, SUM(stake_amount) AS delegated_amount
+  FROM active
+  WHERE type = 'delegator'
+  GROUP BY node_id
+),
+total AS (
+  SELECT SUM(stake_amount) AS amount
+  FROM active
+  WHERE type = 'validator'
+)
+SELECT
+  active.node_id,
+  active.stake_amount,
+  (active.stake_amount * 100.0 / total.amount)::FLOAT AS stake_percent,
+  active.reward_address,
+  TRUE AS active,
+  active.start_time AS active_start_time,
+  active.end_time AS active_end_time,
+  COALESCE(delegated.delegations_count, 0) AS delegations_count,
+  COALESCE(delegated.delegated_amount, 0) AS delegated_amount,
+  COALESCE(active.delegation_fee, 0)::FLOAT AS delegation_fee,
+  active.added_height AS first_height
+FROM active
+CROSS JOIN total
+LEFT JOIN delegated ON delegated.node_id = active.node_id
+WHERE
+  active.type = 'validator'
+  AND (? = '' OR active.reward_address = ?)
+ORDER BY active.stake_amount
\ No newline at end of file
@@ -0,0 +1,4 @@
+DELETE FROM token_allowances
+WHERE (token, owner, spender) IN (
+  SELECT * FROM UNNEST(?::TEXT[], ?::TEXT[], ?::TEXT[])
+)
\ No newline at end of file
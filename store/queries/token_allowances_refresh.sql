@@ -0,0 +1,10 @@
+INSERT INTO token_allowances (token, owner, spender, amount, block_height, tx_hash, updated_at)
+SELECT token, owner, spender, amount, block_height, tx_hash, timestamp
+FROM (
+  SELECT DISTINCT ON (token, owner, spender)
+    token, owner, spender, amount, block_height, tx_hash, timestamp
+  FROM token_approvals
+  WHERE (token, owner, spender) IN (SELECT * FROM UNNEST(?::TEXT[], ?::TEXT[], ?::TEXT[]))
+  ORDER BY token, owner, spender, block_height DESC, log_index DESC
+) latest
+WHERE amount > 0
\ No newline at end of file
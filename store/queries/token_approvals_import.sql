@@ -0,0 +1,12 @@
+INSERT INTO token_approvals (
+  tx_hash,
+  block_height,
+  log_index,
+  token,
+  owner,
+  spender,
+  amount,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (block_height, log_index) DO NOTHING
\ No newline at end of file
@@ -0,0 +1,12 @@
+INSERT INTO token_transfers (
+  tx_hash,
+  block_height,
+  log_index,
+  token,
+  from_address,
+  to_address,
+  amount,
+  timestamp
+)
+VALUES @values
+ON CONFLICT (block_height, log_index) DO NOTHING
\ No newline at end of file
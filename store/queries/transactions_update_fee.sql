@@ -0,0 +1,6 @@
+UPDATE transactions
+SET
+  fee = ?,
+  metadata = COALESCE(metadata, '{}'::JSONB) || ?::JSONB
+WHERE
+  id = ?
\ No newline at end of file
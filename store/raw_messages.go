@@ -136,3 +136,47 @@ func (s RawMessagesStore) GetMessages(input GetRawMessagesInput) ([]model.RawMes
 func (s RawMessagesStore) MarkMessageProcessed(message *model.RawMessage) error {
 	return s.Exec("UPDATE raw_messages SET processed_at = ? WHERE id = ?", time.Now().UTC(), message.ID).Error
 }
+
+// LastMessage returns the most recent message of the topic
+func (s RawMessagesStore) LastMessage(topic int) (*model.RawMessage, error) {
+	msg := &model.RawMessage{}
+
+	err := s.
+		Model(msg).
+		Where("topic_id = ?", topic).
+		Order("id DESC").
+		First(msg).
+		Error
+
+	return msg, checkErr(err)
+}
+
+// FirstMessageSince returns the message of the container with the given hash. Containers
+// that were not ingested resolve to the first message created at or after the given time.
+func (s RawMessagesStore) FirstMessageSince(topic int, hash string, since time.Time) (*model.RawMessage, error) {
+	msg := &model.RawMessage{}
+
+	err := s.
+		Model(msg).
+		Where("topic_id = ? AND hash = ?", topic, hash).
+		Order("id ASC").
+		First(msg).
+		Error
+	if err = checkErr(err); err != ErrNotFound {
+		return msg, err
+	}
+
+	err = s.
+		Model(msg).
+		Where("topic_id = ? AND created_at >= ?", topic, since).
+		Order("id ASC").
+		First(msg).
+		Error
+
+	return msg, checkErr(err)
+}
+
+// ResetProcessed marks messages of the topic starting at the given ID as not processed
+func (s RawMessagesStore) ResetProcessed(topic int, startID int) error {
+	return s.Exec("UPDATE raw_messages SET processed_at = NULL WHERE topic_id = ? AND id >= ?", topic, startID).Error
+}
@@ -0,0 +1,175 @@
+package store
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type ReindexStore struct {
+	*gorm.DB
+}
+
+// RewindChainByTime removes chain blocks and transactions created at or after the given time
+func (s ReindexStore) RewindChainByTime(chain string, start time.Time) error {
+	return s.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(queries.ReindexSelectTxsByTime, chain, start).Error; err != nil {
+			return err
+		}
+		if err := deleteSelectedTxs(tx); err != nil {
+			return err
+		}
+		return tx.Where("chain = ? AND timestamp >= ?", chain, start).Delete(&model.Block{}).Error
+	})
+}
+
+// RewindChainByHeight removes chain blocks and transactions starting at the given height
+func (s ReindexStore) RewindChainByHeight(chain string, height uint64) error {
+	return s.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(queries.ReindexSelectTxsByHeight, chain, height).Error; err != nil {
+			return err
+		}
+		if err := deleteSelectedTxs(tx); err != nil {
+			return err
+		}
+		if err := tx.Where("chain = ? AND height >= ?", chain, height).Delete(&model.EvmBlock{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("chain = ? AND height >= ?", chain, height).Delete(&model.Block{}).Error
+	})
+}
+
+// RewindEvmData removes evm receipts, traces, internal transactions, logs, token and NFT transfers, approvals, balance changes, DEX events, contracts of chain transactions starting at the given height.
+// Statuses and fees set by the EVM worker are reset, so they are derived again from the receipts.
+func (s ReindexStore) RewindEvmData(chain string, height uint64) error {
+	return s.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(queries.ReindexSelectTxsByHeight, chain, height).Error; err != nil {
+			return err
+		}
+		if err := deleteSelectedNftTransfers(tx); err != nil {
+			return err
+		}
+		if err := deleteSelectedTokenApprovals(tx); err != nil {
+			return err
+		}
+		return execQueries(tx,
+			queries.ReindexDeleteEvmReceipts,
+			queries.ReindexDeleteEvmTraces,
+			queries.ReindexDeleteEvmInternalTxs,
+			queries.ReindexDeleteEvmLogs,
+			queries.ReindexDeleteTokenTransfers,
+			queries.ReindexDeleteEvmTxBalanceChanges,
+			queries.ReindexDeleteDexSwaps,
+			queries.ReindexDeleteDexLiquidityEvents,
+			queries.ReindexDeleteContractImplementations,
+			queries.ReindexDeleteContracts,
+			queries.ReindexResetContractImplementations,
+			queries.ReindexResetEvmTransactions,
+			queries.ReindexDropTxs,
+		)
+	})
+}
+
+// RewindEvents removes chain events and staking period changes starting at the given height
+func (s ReindexStore) RewindEvents(chain string, height uint64) error {
+	err := s.
+		Where("chain = ? AND block_height >= ?", chain, height).
+		Delete(&model.Event{}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	if err := s.Exec(queries.ReindexResetDelegations, chain, height).Error; err != nil {
+		return err
+	}
+	if err := s.Exec(queries.ReindexDeleteStakingPeriods, chain, height).Error; err != nil {
+		return err
+	}
+	return s.Exec(queries.ReindexResetStakingPeriods, chain, height).Error
+}
+
+// deleteSelectedTxs removes transactions selected into the reindex table along with
+// all their derived records, in dependency order.
+func deleteSelectedTxs(tx *gorm.DB) error {
+	if err := deleteSelectedNftTransfers(tx); err != nil {
+		return err
+	}
+	if err := deleteSelectedTokenApprovals(tx); err != nil {
+		return err
+	}
+	return execQueries(tx,
+		queries.ReindexUnspendOutputs,
+		queries.ReindexDeleteInputs,
+		queries.ReindexDeleteRewardOutputs,
+		queries.ReindexDeleteRewards,
+		queries.ReindexDeleteOutputs,
+		queries.ReindexDeleteRewardsOwnerOutputs,
+		queries.ReindexDeleteRewardsOwnerAddresses,
+		queries.ReindexDeleteRewardsOwners,
+		queries.ReindexDeleteEvmReceipts,
+		queries.ReindexDeleteEvmTraces,
+		queries.ReindexDeleteEvmInternalTxs,
+		queries.ReindexDeleteEvmLogs,
+		queries.ReindexDeleteTokenTransfers,
+		queries.ReindexDeleteEvmTransactions,
+		queries.ReindexDeleteEvmBalanceChanges,
+		queries.ReindexDeleteDexSwaps,
+		queries.ReindexDeleteDexLiquidityEvents,
+		queries.ReindexDeleteContractImplementations,
+		queries.ReindexDeleteContracts,
+		queries.ReindexResetContractImplementations,
+		queries.ReindexDeleteAssets,
+		queries.ReindexDeleteChains,
+		queries.ReindexDeleteTransactions,
+		queries.ReindexDropTxs,
+	)
+}
+
+// deleteSelectedNftTransfers removes NFT transfers of the selected transactions and
+// reverts their balance changes on owners of the affected tokens.
+func deleteSelectedNftTransfers(tx *gorm.DB) error {
+	keys := []NftKey{}
+	if err := tx.Raw(queries.ReindexSelectNftTokens).Scan(&keys).Error; err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := tx.Exec(queries.ReindexDeleteNftTransfers).Error; err != nil {
+		return err
+	}
+	if err := deleteEmptyNftOwners(tx, keys); err != nil {
+		return err
+	}
+
+	tokens, ids := nftKeyArrays(keys)
+	return tx.Exec(queries.ReindexResetNftOwners, tokens, ids).Error
+}
+
+// deleteSelectedTokenApprovals removes token approvals of the selected transactions and
+// recalculates the affected allowances.
+func deleteSelectedTokenApprovals(tx *gorm.DB) error {
+	keys := []AllowanceKey{}
+	if err := tx.Raw(queries.ReindexSelectApprovalKeys).Scan(&keys).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Exec(queries.ReindexDeleteTokenApprovals).Error; err != nil {
+		return err
+	}
+	return refreshTokenAllowances(tx, keys)
+}
+
+func execQueries(tx *gorm.DB, list ...string) error {
+	for _, query := range list {
+		if err := tx.Exec(query).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package store
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type RewardsStore struct {
+	*gorm.DB
+}
+
+type RewardsSearch struct {
+	Address string `form:"address"`
+	NodeID  string `form:"node_id"`
+	Limit   int    `form:"limit"`
+	Offset  int    `form:"offset"`
+	Page    int    `form:"page"`
+}
+
+func (input *RewardsSearch) Validate() error {
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+// PendingTxs returns reward validator transactions without processed rewards
+func (s RewardsStore) PendingTxs(chain string, limit int) ([]model.Transaction, error) {
+	result := []model.Transaction{}
+	err := s.Raw(queries.RewardsPendingTxs, chain, limit).Scan(&result).Error
+	return result, err
+}
+
+// Create creates or updates the reward record of the staking transaction
+func (s RewardsStore) Create(reward *model.Reward) error {
+	return s.Exec(queries.RewardsCreate,
+		reward.ID,
+		reward.TransactionID,
+		reward.Rewarded,
+		reward.RewardedAt,
+		reward.ProcessedAt,
+	).Error
+}
+
+// Find returns the processed reward of the staking transaction
+func (s RewardsStore) Find(stakingTxID string) (*model.Reward, error) {
+	result := &model.Reward{}
+
+	err := s.Model(result).Take(result, "id = ?", stakingTxID).Error
+	if err != nil {
+		return nil, checkErr(err)
+	}
+
+	return result, nil
+}
+
+// Outputs returns the stored reward outputs paid out for the staking transaction
+func (s RewardsStore) Outputs(stakingTxID string) ([]model.Output, error) {
+	result := []model.Output{}
+
+	err := s.
+		Model(&model.Output{}).
+		Where("tx_id = ? AND reward = TRUE", stakingTxID).
+		Order("index ASC").
+		Find(&result).
+		Error
+
+	return result, err
+}
+
+// MarkSpentOutputs marks reward outputs consumed by already indexed transactions as spent
+func (s RewardsStore) MarkSpentOutputs(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.Exec(queries.RewardsMarkSpentOutputs, ids).Error
+}
+
+// OwnerAddresses returns the rewards owner addresses of the staking transaction
+func (s RewardsStore) OwnerAddresses(txID string) ([]string, error) {
+	result := []string{}
+
+	err := s.
+		Table("rewards_owner_addresses").
+		Where("id = ?", txID).
+		Order("index ASC").
+		Pluck("address", &result).
+		Error
+
+	return result, err
+}
+
+// Search returns reward outputs matching the search input
+func (s RewardsStore) Search(input *RewardsSearch) ([]model.RewardOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.
+		Table("transaction_outputs").
+		Select(`
+			transaction_outputs.id,
+			transaction_outputs.tx_id AS staking_tx_id,
+			staking.type AS staking_type,
+			rewards.transaction_id AS reward_tx_id,
+			staking.metadata->>'node_id' AS node_id,
+			transaction_outputs.asset,
+			transaction_outputs.amount,
+			transaction_outputs.addresses,
+			transaction_outputs.spent,
+			rewards.rewarded_at`).
+		Joins("INNER JOIN rewards ON rewards.id = transaction_outputs.tx_id").
+		Joins("INNER JOIN transactions staking ON staking.id = transaction_outputs.tx_id").
+		Where("transaction_outputs.reward = TRUE")
+
+	if input.Address != "" {
+		scope = scope.Where("? = ANY(transaction_outputs.addresses)", input.Address)
+	}
+	if input.NodeID != "" {
+		scope = scope.Where("staking.metadata->>'node_id' = ?", input.NodeID)
+	}
+
+	result := []model.RewardOutput{}
+
+	err := scope.
+		Order("rewards.rewarded_at DESC, transaction_outputs.id ASC").
+		Offset(input.Offset).
+		Limit(input.Limit).
+		Scan(&result).
+		Error
+
+	return result, err
+}
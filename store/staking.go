@@ -0,0 +1,145 @@
+package store
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+var (
+	reHeight = regexp.MustCompile(`^[\d]{1,9}$`)
+
+	errInvalidAt = errors.New("invalid at value, expected time or block height")
+)
+
+type StakingStore struct {
+	*gorm.DB
+}
+
+type StakingPeriodsSearch struct {
+	NodeID string `form:"node_id"`
+	Type   string `form:"type"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+	Page   int    `form:"page"`
+}
+
+func (input *StakingPeriodsSearch) Validate() error {
+	switch input.Type {
+	case "", model.StakingTypeValidator, model.StakingTypeDelegator:
+	default:
+		return errors.New("invalid type value")
+	}
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+// CreatePeriod creates or updates the staking period of the add validator or delegator transaction
+func (s StakingStore) CreatePeriod(period *model.StakingPeriod) error {
+	return s.Exec(queries.StakingPeriodsCreate,
+		period.ID,
+		period.Chain,
+		period.Type,
+		period.NodeID,
+		period.StakeAmount,
+		period.DelegationFee,
+		period.RewardAddress,
+		period.StartTime,
+		period.EndTime,
+		period.AddedHeight,
+		period.AddedAt,
+	).Error
+}
+
+// FinishPeriod records the reward validator transaction that ended the staking period
+func (s StakingStore) FinishPeriod(id string, rewardTxID string, height uint64, ts time.Time, rewarded bool) error {
+	return s.Exec(queries.StakingPeriodsFinish, height, ts, rewardTxID, rewarded, id).Error
+}
+
+// GetPeriod returns the staking period of the add validator or delegator transaction
+func (s StakingStore) GetPeriod(id string) (*model.StakingPeriod, error) {
+	result := &model.StakingPeriod{}
+
+	err := s.Model(result).Take(result, "id = ?", id).Error
+	if err != nil {
+		return nil, checkErr(err)
+	}
+
+	return result, nil
+}
+
+// SearchPeriods returns staking periods matching the search input
+func (s StakingStore) SearchPeriods(input *StakingPeriodsSearch) ([]model.StakingPeriod, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.StakingPeriod{})
+
+	if input.NodeID != "" {
+		scope = scope.Where("node_id = ?", input.NodeID)
+	}
+	if input.Type != "" {
+		scope = scope.Where("type = ?", input.Type)
+	}
+
+	result := []model.StakingPeriod{}
+
+	err := scope.
+		Order("start_time DESC, id ASC").
+		Offset(input.Offset).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
+
+// validateStakingAt checks that the value is a time or a P-chain block height
+func validateStakingAt(at string) error {
+	if reHeight.MatchString(at) {
+		return nil
+	}
+	if _, err := parseTimeFilter(at, "bod"); err != nil {
+		return errInvalidAt
+	}
+	return nil
+}
+
+// stakingTime returns the time of the staking set lookup, block heights are resolved
+// into the P-chain block time.
+func stakingTime(db *gorm.DB, at string) (*time.Time, error) {
+	if !reHeight.MatchString(at) {
+		ts, err := parseTimeFilter(at, "bod")
+		if err != nil {
+			return nil, errInvalidAt
+		}
+		return ts, nil
+	}
+
+	height, err := strconv.ParseUint(at, 10, 64)
+	if err != nil {
+		return nil, errInvalidAt
+	}
+
+	rows, err := db.Raw(queries.StakingBlockTime, height).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrNotFound
+	}
+
+	var result time.Time
+	return &result, rows.Scan(&result)
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStakingAt(t *testing.T) {
+	examples := []struct {
+		at  string
+		err error
+	}{
+		{"0", nil},
+		{"1", nil},
+		{"123456789", nil},
+		{"1640000000", nil},
+		{"2021-12-20", nil},
+		{"2021-12-20T11:33:20Z", nil},
+		{"12345678901", errInvalidAt},
+		{"-1", errInvalidAt},
+		{"1.5", errInvalidAt},
+		{"2021-13-45", errInvalidAt},
+		{"latest", errInvalidAt},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.at, func(t *testing.T) {
+			assert.Equal(t, ex.err, validateStakingAt(ex.at))
+		})
+	}
+}
+
+func TestStakingTime(t *testing.T) {
+	examples := []struct {
+		at       string
+		expected time.Time
+		err      error
+	}{
+		{"1640000000", time.Unix(1640000000, 0), nil},
+		{"2021-12-20", time.Date(2021, 12, 20, 0, 0, 0, 0, time.UTC), nil},
+		{"2021-12-20T11:33:20Z", time.Date(2021, 12, 20, 11, 33, 20, 0, time.UTC), nil},
+		{"12345678901", time.Time{}, errInvalidAt},
+		{"latest", time.Time{}, errInvalidAt},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.at, func(t *testing.T) {
+			// Time values are parsed without a block time lookup
+			ts, err := stakingTime(nil, ex.at)
+			if ex.err != nil {
+				assert.Equal(t, ex.err, err)
+				assert.Nil(t, ts)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, ex.expected.Equal(*ts), "expected %v, got %v", ex.expected, *ts)
+		})
+	}
+}
@@ -34,6 +34,19 @@ type DB struct {
 	Transactions TransactionsStore
 	Assets       AssetsStore
 	Events       EventsStore
+
+	FailedContainers FailedContainersStore
+	Reindex          ReindexStore
+	EvmLogs          EvmLogsStore
+	Tokens           TokensStore
+	Nfts             NftsStore
+	EvmInternalTxs   EvmInternalTxsStore
+	ContractAbis     ContractAbisStore
+	Evm              EvmStore
+	Contracts        ContractsStore
+	Dex              DexStore
+	Rewards          RewardsStore
+	Staking          StakingStore
 }
 
 func NewRaw(connStr string) (*gorm.DB, error) {
@@ -78,6 +91,10 @@ func New(connStr string) (*DB, error) {
 		return nil, err
 	}
 
+	return newDB(conn), nil
+}
+
+func newDB(conn *gorm.DB) *DB {
 	return &DB{
 		db: conn,
 
@@ -90,7 +107,29 @@ func New(connStr string) (*DB, error) {
 		Transactions: TransactionsStore{conn},
 		Assets:       AssetsStore{conn},
 		Events:       EventsStore{conn},
-	}, nil
+
+		FailedContainers: FailedContainersStore{conn},
+		Reindex:          ReindexStore{conn},
+		EvmLogs:          EvmLogsStore{conn},
+		Tokens:           TokensStore{conn},
+		Nfts:             NftsStore{conn},
+		EvmInternalTxs:   EvmInternalTxsStore{conn},
+		ContractAbis:     ContractAbisStore{conn},
+		Evm:              EvmStore{conn},
+		Contracts:        ContractsStore{conn},
+		Dex:              DexStore{conn},
+		Rewards:          RewardsStore{conn},
+		Staking:          StakingStore{conn},
+	}
+}
+
+// Transaction executes the function with a DB clone scoped to a single database transaction.
+// All writes performed through the clone are committed together, or rolled back if the
+// function returns an error.
+func (s DB) Transaction(fn func(*DB) error) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return fn(newDB(tx))
+	})
 }
 
 func (s DB) Test() error {
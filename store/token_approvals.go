@@ -0,0 +1,117 @@
+package store
+
+import (
+	"math/big"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+var (
+	// Allowances at or above 2^255 are treated as unlimited, wallets usually approve max uint256
+	unlimitedAllowance = new(big.Int).Lsh(big.NewInt(1), 255)
+)
+
+type TokenAllowancesSearch struct {
+	Token     string `form:"token"`
+	Owner     string `form:"owner"`
+	Spender   string `form:"spender"`
+	Unlimited bool   `form:"unlimited"`
+	Limit     int    `form:"limit"`
+	Offset    int    `form:"offset"`
+	Page      int    `form:"page"`
+}
+
+// AllowanceKey identifies the allowance of a spender
+type AllowanceKey struct {
+	Token   string
+	Owner   string
+	Spender string
+}
+
+func (input *TokenAllowancesSearch) Validate() error {
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+// ImportApprovals creates token approval records in bulk
+func (s TokensStore) ImportApprovals(records []model.TokenApproval) error {
+	return bulkImport(s.DB, queries.TokenApprovalsImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.BlockHeight,
+			r.LogIndex,
+			r.Token,
+			r.Owner,
+			r.Spender,
+			r.Amount,
+			r.Timestamp,
+		}
+	})
+}
+
+// RefreshAllowances recalculates current allowances from the approval history
+func (s TokensStore) RefreshAllowances(keys []AllowanceKey) error {
+	return refreshTokenAllowances(s.DB, keys)
+}
+
+// SearchAllowances returns outstanding allowances matching the search input
+func (s TokensStore) SearchAllowances(input *TokenAllowancesSearch) ([]model.TokenAllowance, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.TokenAllowance{})
+
+	if input.Token != "" {
+		scope = scope.Where("token = ?", input.Token)
+	}
+	if input.Owner != "" {
+		scope = scope.Where("owner = ?", input.Owner)
+	}
+	if input.Spender != "" {
+		scope = scope.Where("spender = ?", input.Spender)
+	}
+	if input.Unlimited {
+		scope = scope.Where("amount >= ?", unlimitedAllowance.String())
+	}
+
+	result := []model.TokenAllowance{}
+
+	err := scope.
+		Order("block_height DESC, token ASC, spender ASC").
+		Offset(input.Offset).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	for idx := range result {
+		result[idx].Unlimited = result[idx].Amount.Int != nil && result[idx].Amount.Cmp(unlimitedAllowance) >= 0
+	}
+
+	return result, err
+}
+
+func refreshTokenAllowances(db *gorm.DB, keys []AllowanceKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	tokens := make(pq.StringArray, len(keys))
+	owners := make(pq.StringArray, len(keys))
+	spenders := make(pq.StringArray, len(keys))
+	for idx, key := range keys {
+		tokens[idx] = key.Token
+		owners[idx] = key.Owner
+		spenders[idx] = key.Spender
+	}
+
+	if err := db.Exec(queries.TokenAllowancesDelete, tokens, owners, spenders).Error; err != nil {
+		return err
+	}
+	return db.Exec(queries.TokenAllowancesRefresh, tokens, owners, spenders).Error
+}
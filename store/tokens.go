@@ -0,0 +1,191 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
+)
+
+type TokensStore struct {
+	*gorm.DB
+}
+
+type TokensSearch struct {
+	Type   string `form:"type"`
+	Symbol string `form:"symbol"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+	Page   int    `form:"page"`
+}
+
+type TokenTransfersSearch struct {
+	Token       string `form:"token"`
+	Address     string `form:"address"`
+	StartHeight int    `form:"start_height"`
+	EndHeight   int    `form:"end_height"`
+	Order       string `form:"order"`
+	Cursor      string `form:"cursor"`
+	Limit       int    `form:"limit"`
+
+	cursor *evmLogCursor
+}
+
+func (input *TokensSearch) Validate() error {
+	return validatePagination(&input.Limit, &input.Offset, input.Page)
+}
+
+func (input *TokenTransfersSearch) Validate() error {
+	if input.StartHeight < 0 {
+		return errors.New("invalid start height")
+	}
+	if input.EndHeight < 0 {
+		return errors.New("invalid end height")
+	}
+	if input.EndHeight > 0 && input.EndHeight < input.StartHeight {
+		return errors.New("end height must be greater than start height")
+	}
+
+	switch input.Order {
+	case "":
+		input.Order = "desc"
+	case "asc", "desc":
+	default:
+		return errors.New("invalid order")
+	}
+
+	if input.Cursor != "" {
+		cursor := &evmLogCursor{}
+		if _, err := fmt.Sscanf(input.Cursor, "%d:%d", &cursor.height, &cursor.index); err != nil {
+			return errors.New("invalid cursor value")
+		}
+		input.cursor = cursor
+	}
+
+	if input.Limit < 0 {
+		return errors.New("invalid limit value")
+	}
+	if input.Limit == 0 {
+		input.Limit = 100
+	}
+	if input.Limit > 1000 {
+		return errors.New("limit param max value is 1000")
+	}
+
+	return nil
+}
+
+// NextCursor returns the cursor for the page following the given transfers
+func (input *TokenTransfersSearch) NextCursor(transfers []model.TokenTransfer) string {
+	if len(transfers) < input.Limit {
+		return ""
+	}
+	last := transfers[len(transfers)-1]
+	return fmt.Sprintf("%d:%d", last.BlockHeight, last.LogIndex)
+}
+
+// Create creates a token record unless it already exists
+func (s TokensStore) Create(token *model.Token) error {
+	return s.
+		Model(token).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(token).
+		Error
+}
+
+// Get returns a token by its contract address
+func (s TokensStore) Get(address string) (*model.Token, error) {
+	token := &model.Token{}
+	err := s.Model(token).First(token, "address = ?", address).Error
+	return token, checkErr(err)
+}
+
+// Search returns tokens matching the search input
+func (s TokensStore) Search(input *TokensSearch) ([]model.Token, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.Token{})
+
+	if input.Type != "" {
+		scope = scope.Where("type = ?", input.Type)
+	}
+	if input.Symbol != "" {
+		scope = scope.Where("LOWER(symbol) = ?", strings.ToLower(input.Symbol))
+	}
+
+	result := []model.Token{}
+
+	err := scope.
+		Order("created_at ASC, address ASC").
+		Offset(input.Offset).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
+
+// ImportTransfers creates token transfer records in bulk
+func (s TokensStore) ImportTransfers(records []model.TokenTransfer) error {
+	return bulkImport(s.DB, queries.TokenTransfersImport, len(records), func(i int) Row {
+		r := records[i]
+
+		return Row{
+			r.TxHash,
+			r.BlockHeight,
+			r.LogIndex,
+			r.Token,
+			r.From,
+			r.To,
+			r.Amount,
+			r.Timestamp,
+		}
+	})
+}
+
+// SearchTransfers returns token transfers matching the search input
+func (s TokensStore) SearchTransfers(input *TokenTransfersSearch) ([]model.TokenTransfer, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := s.Model(&model.TokenTransfer{})
+
+	if input.Token != "" {
+		scope = scope.Where("token = ?", input.Token)
+	}
+	if input.Address != "" {
+		scope = scope.Where("(from_address = ? OR to_address = ?)", input.Address, input.Address)
+	}
+	if input.StartHeight > 0 {
+		scope = scope.Where("block_height >= ?", input.StartHeight)
+	}
+	if input.EndHeight > 0 {
+		scope = scope.Where("block_height <= ?", input.EndHeight)
+	}
+
+	if cursor := input.cursor; cursor != nil {
+		if input.Order == "asc" {
+			scope = scope.Where("(block_height, log_index) > (?, ?)", cursor.height, cursor.index)
+		} else {
+			scope = scope.Where("(block_height, log_index) < (?, ?)", cursor.height, cursor.index)
+		}
+	}
+
+	result := []model.TokenTransfer{}
+
+	err := scope.
+		Order(fmt.Sprintf("block_height %s, log_index %s", input.Order, input.Order)).
+		Limit(input.Limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
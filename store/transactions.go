@@ -8,6 +8,8 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/figment-networks/avalanche-indexer/model"
+	"github.com/figment-networks/avalanche-indexer/model/types"
+	"github.com/figment-networks/avalanche-indexer/store/queries"
 )
 
 var (
@@ -60,6 +62,10 @@ func (store TransactionsStore) Search(input *TxSearchInput) (*TxSearchOutput, er
 		scope = scope.Where("transactions.type IN (?)", input.types)
 	}
 
+	if len(input.statuses) > 0 {
+		scope = scope.Where("transactions.status IN (?)", input.statuses)
+	}
+
 	if input.Memo != "" {
 		words := strings.Split(strings.TrimSpace(input.Memo), " ")
 		for _, word := range words {
@@ -209,3 +215,13 @@ func (s TransactionsStore) GetTypeCounts(chain string) ([]model.TransactionTypeC
 
 	return result, err
 }
+
+// UpdateFee sets the transaction fee and merges the fee details into its metadata
+func (s TransactionsStore) UpdateFee(id string, fee uint64, meta types.Map) error {
+	return s.Exec(queries.TransactionsUpdateFee, fee, meta, id).Error
+}
+
+// UpdateStatus sets the transaction status and merges the status details into its metadata
+func (s TransactionsStore) UpdateStatus(id string, status string, meta types.Map) error {
+	return s.Exec(queries.TransactionsUpdateStatus, status, meta, id).Error
+}
@@ -21,6 +21,7 @@ func init() {
 type TxSearchInput struct {
 	Chain       string `form:"chain"`
 	Type        string `form:"type"`
+	Status      string `form:"status"`
 	Address     string `form:"address"`
 	Asset       string `form:"asset"`
 	Memo        string `form:"memo"`
@@ -39,6 +40,7 @@ type TxSearchInput struct {
 	startTime *time.Time
 	endTime   *time.Time
 	types     []string
+	statuses  []string
 }
 
 func (input *TxSearchInput) Validate() error {
@@ -86,6 +88,18 @@ func (input *TxSearchInput) Validate() error {
 		}
 	}
 
+	if input.Status != "" {
+		input.statuses = strings.Split(input.Status, ",")
+
+		for _, status := range input.statuses {
+			switch status {
+			case model.TxStatusAccepted, model.TxStatusRejected, model.TxStatusReverted:
+			default:
+				return fmt.Errorf("invalid transaction status: %s", status)
+			}
+		}
+	}
+
 	switch input.Order {
 	case "":
 		input.Order = "time_desc"
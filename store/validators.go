@@ -56,6 +56,10 @@ func (s ValidatorsStore) FindByNodeID(id string) (*model.Validator, error) {
 }
 
 func (s ValidatorsStore) Search(search ValidatorsSearch) ([]model.Validator, error) {
+	if search.At != "" {
+		return s.searchAt(search)
+	}
+
 	result := []model.Validator{}
 
 	scope := s.
@@ -78,6 +82,23 @@ func (s ValidatorsStore) Search(search ValidatorsSearch) ([]model.Validator, err
 	return result, checkErr(err)
 }
 
+// searchAt returns the validator set at the given time or height derived from staking periods
+func (s ValidatorsStore) searchAt(search ValidatorsSearch) ([]model.Validator, error) {
+	at, err := stakingTime(s.DB, search.At)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []model.Validator{}
+
+	err = s.
+		Raw(queries.StakingValidatorsAt, at, search.RewardAddress, search.RewardAddress).
+		Scan(&result).
+		Error
+
+	return result, err
+}
+
 func (s ValidatorsStore) Import(records []model.Validator) error {
 	if err := s.Exec("UPDATE validators SET active = FALSE").Error; err != nil {
 		return err
@@ -173,6 +194,8 @@ type ValidatorsSearch struct {
 	RewardAddress      string `form:"reward_address"`
 	CapacityPercentMin uint   `form:"capacity_percent_min"`
 	CapacityPercentMax uint   `form:"capacity_percent_max"`
+	Status             string `form:"status"`
+	At                 string `form:"at"`
 }
 
 func (s ValidatorsSearch) Validate() error {
@@ -182,5 +205,20 @@ func (s ValidatorsSearch) Validate() error {
 	if s.CapacityPercentMax > 100 {
 		return errors.New("capacity_percent_max must be below 100")
 	}
+	switch s.Status {
+	case "", model.ValidatorStatusActive:
+	case model.ValidatorStatusPending:
+		if s.At != "" || s.CapacityPercentMin > 0 || s.CapacityPercentMax > 0 {
+			return errors.New("only reward_address filter is supported for pending validators")
+		}
+	default:
+		return errors.New("invalid status value")
+	}
+	if s.At != "" {
+		if s.CapacityPercentMin > 0 || s.CapacityPercentMax > 0 {
+			return errors.New("capacity filters are not supported for historical validators")
+		}
+		return validateStakingAt(s.At)
+	}
 	return nil
 }
@@ -1,5 +1,8 @@
 package util
 
+// WeiPerNavax is the number of wei in 1 nAVAX, C-chain amounts use 18 decimals instead of 9
+const WeiPerNavax = 1_000_000_000
+
 func PercentOf(value, total int64) float64 {
 	return (float64(value) * 100.0) / float64(total)
 }